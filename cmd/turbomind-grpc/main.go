@@ -0,0 +1,66 @@
+// Command turbomind-grpc hosts a single loaded TurboMind model behind the
+// grpcbackend.TurboMindService.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+	"github.com/xianml/turbomind-go/pkg/turbomind/grpcbackend"
+	"github.com/xianml/turbomind-go/pkg/turbomind/grpcbackend/grpcbackendpb"
+)
+
+func main() {
+	libraryPath := flag.String("library", "", "path to the TurboMind shared library")
+	modelPath := flag.String("model", "", "path to the model directory")
+	modelFormat := flag.String("model-format", "hf", "model format (hf, awq, gptq, ...)")
+	tp := flag.Int("tp", 1, "tensor parallelism degree")
+	sessionLen := flag.Int("session-len", 4096, "max sequence length")
+	maxBatchSize := flag.Int("max-batch-size", 8, "max batch size")
+	addr := flag.String("addr", ":50051", "gRPC listen address")
+	flag.Parse()
+
+	if *modelPath == "" {
+		log.Fatal("turbomind-grpc: -model is required")
+	}
+
+	if err := turbomind.Initialize(*libraryPath); err != nil {
+		log.Fatalf("turbomind-grpc: initialize: %v", err)
+	}
+
+	config := turbomind.Config{
+		ModelPath:    *modelPath,
+		ModelFormat:  *modelFormat,
+		TP:           *tp,
+		SessionLen:   *sessionLen,
+		MaxBatchSize: *maxBatchSize,
+	}
+
+	engine, err := turbomind.NewEngine(config)
+	if err != nil {
+		log.Fatalf("turbomind-grpc: new engine: %v", err)
+	}
+
+	tokenizer, err := turbomind.NewTokenizer(*modelPath)
+	if err != nil {
+		log.Fatalf("turbomind-grpc: new tokenizer: %v", err)
+	}
+	defer tokenizer.Close()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("turbomind-grpc: listen on %s: %v", *addr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	grpcbackendpb.RegisterTurboMindServiceServer(grpcServer, grpcbackend.NewServer(engine, tokenizer, config))
+
+	log.Printf("turbomind-grpc: serving %s on %s", *modelPath, *addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("turbomind-grpc: serve: %v", err)
+	}
+}
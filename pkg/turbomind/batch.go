@@ -0,0 +1,25 @@
+package turbomind
+
+// packBatch lays out requests in TurboMind's [batch, seq] tensor shape: it
+// right-pads every request's Tokens to the batch's longest sequence with
+// zeros and records each request's real length in seqLens, so the engine
+// can tell real tokens from padding via the accompanying sequence_length
+// tensor. Pure layout math, split out of ForwardBatch so it can be tested
+// without a model instance.
+func packBatch(requests []*BatchRequest) (inputIDs []int32, seqLens []int32, maxLen int) {
+	for _, req := range requests {
+		if len(req.Tokens) > maxLen {
+			maxLen = len(req.Tokens)
+		}
+	}
+
+	batchSize := len(requests)
+	inputIDs = make([]int32, batchSize*maxLen)
+	seqLens = make([]int32, batchSize)
+	for i, req := range requests {
+		copy(inputIDs[i*maxLen:], req.Tokens)
+		seqLens[i] = int32(len(req.Tokens))
+	}
+
+	return inputIDs, seqLens, maxLen
+}
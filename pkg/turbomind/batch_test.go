@@ -0,0 +1,58 @@
+package turbomind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackBatchPadsToLongestSequence(t *testing.T) {
+	requests := []*BatchRequest{
+		{Tokens: []int32{1, 2, 3}},
+		{Tokens: []int32{4}},
+		{Tokens: []int32{5, 6}},
+	}
+
+	inputIDs, seqLens, maxLen := packBatch(requests)
+
+	assert.Equal(t, 3, maxLen)
+	assert.Equal(t, []int32{3, 1, 2}, seqLens)
+	assert.Equal(t, []int32{
+		1, 2, 3,
+		4, 0, 0,
+		5, 6, 0,
+	}, inputIDs)
+}
+
+func TestPackBatchSingleRequestNoPadding(t *testing.T) {
+	requests := []*BatchRequest{{Tokens: []int32{7, 8, 9, 10}}}
+
+	inputIDs, seqLens, maxLen := packBatch(requests)
+
+	assert.Equal(t, 4, maxLen)
+	assert.Equal(t, []int32{4}, seqLens)
+	assert.Equal(t, []int32{7, 8, 9, 10}, inputIDs)
+}
+
+func TestPackBatchEmptyTokensStillPadded(t *testing.T) {
+	requests := []*BatchRequest{
+		{Tokens: []int32{1, 2}},
+		{Tokens: nil},
+	}
+
+	inputIDs, seqLens, maxLen := packBatch(requests)
+
+	assert.Equal(t, 2, maxLen)
+	assert.Equal(t, []int32{2, 0}, seqLens)
+	assert.Equal(t, []int32{1, 2, 0, 0}, inputIDs)
+}
+
+func TestPackBatchAllRequestsEmptyYieldsZeroMaxLen(t *testing.T) {
+	requests := []*BatchRequest{{Tokens: nil}, {Tokens: []int32{}}}
+
+	inputIDs, seqLens, maxLen := packBatch(requests)
+
+	assert.Equal(t, 0, maxLen)
+	assert.Equal(t, []int32{0, 0}, seqLens)
+	assert.Empty(t, inputIDs, "ForwardBatch must guard maxLen==0 before indexing inputIDs[0]")
+}
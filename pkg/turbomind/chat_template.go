@@ -0,0 +1,200 @@
+package turbomind
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ChatMessage represents a single turn in a chat conversation passed to
+// Tokenizer.ApplyChatTemplate.
+type ChatMessage struct {
+	Role    string // "system", "user", or "assistant"
+	Content string
+}
+
+// chatTemplate describes how to wrap each role's content when rendering a
+// chat conversation into a single prompt string. This intentionally covers
+// the common "role wrapper tokens" shape used by instruction-tuned models
+// rather than interpreting arbitrary Jinja2 templates.
+type chatTemplate struct {
+	SystemPrefix     string `json:"system_prefix"`
+	SystemSuffix     string `json:"system_suffix"`
+	UserPrefix       string `json:"user_prefix"`
+	UserSuffix       string `json:"user_suffix"`
+	AssistantPrefix  string `json:"assistant_prefix"`
+	AssistantSuffix  string `json:"assistant_suffix"`
+	GenerationPrompt string `json:"generation_prompt"`
+	AddBOS           bool   `json:"add_bos"`
+}
+
+// builtinChatTemplates is the fallback registry used when a model directory
+// doesn't ship its own chat_template.json/tokenizer_config.json, keyed by
+// model family.
+var builtinChatTemplates = map[string]chatTemplate{
+	"llama": {
+		SystemPrefix:     "<<SYS>>\n",
+		SystemSuffix:     "\n<</SYS>>\n\n",
+		UserPrefix:       "[INST] ",
+		UserSuffix:       " [/INST]",
+		AssistantPrefix:  " ",
+		AssistantSuffix:  " </s><s>",
+		GenerationPrompt: "",
+		AddBOS:           true,
+	},
+	"phi": {
+		UserPrefix:       "<|user|>\n",
+		UserSuffix:       "<|end|>\n",
+		AssistantPrefix:  "<|assistant|>\n",
+		AssistantSuffix:  "<|end|>\n",
+		SystemPrefix:     "<|system|>\n",
+		SystemSuffix:     "<|end|>\n",
+		GenerationPrompt: "<|assistant|>\n",
+	},
+	"qwen": {
+		SystemPrefix:     "<|im_start|>system\n",
+		SystemSuffix:     "<|im_end|>\n",
+		UserPrefix:       "<|im_start|>user\n",
+		UserSuffix:       "<|im_end|>\n",
+		AssistantPrefix:  "<|im_start|>assistant\n",
+		AssistantSuffix:  "<|im_end|>\n",
+		GenerationPrompt: "<|im_start|>assistant\n",
+	},
+	"chatml": {
+		SystemPrefix:     "<|im_start|>system\n",
+		SystemSuffix:     "<|im_end|>\n",
+		UserPrefix:       "<|im_start|>user\n",
+		UserSuffix:       "<|im_end|>\n",
+		AssistantPrefix:  "<|im_start|>assistant\n",
+		AssistantSuffix:  "<|im_end|>\n",
+		GenerationPrompt: "<|im_start|>assistant\n",
+	},
+	"mistral": {
+		UserPrefix:       "[INST] ",
+		UserSuffix:       " [/INST]",
+		AssistantPrefix:  " ",
+		AssistantSuffix:  "</s>",
+		GenerationPrompt: "",
+		AddBOS:           true,
+	},
+}
+
+// tokenizerConfigFile is the subset of tokenizer_config.json we care about
+// when looking for a chat template.
+type tokenizerConfigFile struct {
+	ChatTemplate string `json:"chat_template"`
+	ModelFamily  string `json:"model_type"`
+}
+
+// loadChatTemplate resolves the chat template to use for this tokenizer,
+// preferring an explicit chat_template.json or tokenizer_config.json in the
+// model directory and falling back to the builtin registry keyed by
+// t.modelFamily.
+func (t *Tokenizer) loadChatTemplate() (*chatTemplate, error) {
+	if t.modelDir != "" {
+		if tmpl, ok := readChatTemplateJSON(filepath.Join(t.modelDir, "chat_template.json")); ok {
+			return tmpl, nil
+		}
+		if tmpl, family, ok := readTokenizerConfigTemplate(filepath.Join(t.modelDir, "tokenizer_config.json")); ok {
+			if tmpl != nil {
+				return tmpl, nil
+			}
+			if family != "" {
+				if builtin, ok := builtinChatTemplates[family]; ok {
+					return &builtin, nil
+				}
+			}
+		}
+	}
+
+	family := t.modelFamily
+	if family == "" {
+		family = "chatml"
+	}
+	builtin, ok := builtinChatTemplates[family]
+	if !ok {
+		return nil, fmt.Errorf("no chat template available for model family %q", family)
+	}
+	return &builtin, nil
+}
+
+// readChatTemplateJSON reads a structured chat_template.json holding the
+// same fields as chatTemplate directly.
+func readChatTemplateJSON(path string) (*chatTemplate, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var tmpl chatTemplate
+	if err := json.Unmarshal(data, &tmpl); err != nil {
+		return nil, false
+	}
+	return &tmpl, true
+}
+
+// readTokenizerConfigTemplate extracts chat_template/model_type from
+// tokenizer_config.json, if present. We don't attempt to interpret a raw
+// Jinja2 "chat_template" string; if one is present we fall through to the
+// builtin registry keyed by model_type instead.
+func readTokenizerConfigTemplate(path string) (*chatTemplate, string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", false
+	}
+	var cfg tokenizerConfigFile
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, "", false
+	}
+	return nil, strings.ToLower(cfg.ModelFamily), true
+}
+
+// ApplyChatTemplate renders a conversation into the prompt format expected
+// by this model (e.g. Phi-3's `<|user|>\n...<|end|>\n<|assistant|>\n`) and
+// returns both the rendered string and its encoded token IDs, so callers
+// can pass either straight to Engine.Generate. When addGenerationPrompt is
+// true, the template's assistant-turn opener is appended so the model knows
+// to start generating.
+func (t *Tokenizer) ApplyChatTemplate(messages []ChatMessage, addGenerationPrompt bool) (string, []int, error) {
+	tmpl, err := t.loadChatTemplate()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var b strings.Builder
+	for _, msg := range messages {
+		switch strings.ToLower(msg.Role) {
+		case "system":
+			b.WriteString(tmpl.SystemPrefix)
+			b.WriteString(msg.Content)
+			b.WriteString(tmpl.SystemSuffix)
+		case "assistant":
+			b.WriteString(tmpl.AssistantPrefix)
+			b.WriteString(msg.Content)
+			b.WriteString(tmpl.AssistantSuffix)
+		case "user":
+			b.WriteString(tmpl.UserPrefix)
+			b.WriteString(msg.Content)
+			b.WriteString(tmpl.UserSuffix)
+		default:
+			return "", nil, fmt.Errorf("unsupported chat message role %q", msg.Role)
+		}
+	}
+	if addGenerationPrompt {
+		b.WriteString(tmpl.GenerationPrompt)
+	}
+	rendered := b.String()
+
+	var tokens []int
+	if tmpl.AddBOS {
+		tokens, err = t.EncodeWithBOS(rendered)
+	} else {
+		tokens, err = t.Encode(rendered, false)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode rendered chat template: %v", err)
+	}
+
+	return rendered, tokens, nil
+}
@@ -1,28 +1,33 @@
 package turbomind
 
 import (
+	"context"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"unsafe"
 )
 
-// Engine provides a high-level interface for TurboMind inference
-type Engine struct {
+// InstanceEngine provides a high-level interface for TurboMind inference
+type InstanceEngine struct {
 	model     *Model
-	instance  *ModelInstance
+	group     *InstanceGroup
 	tokenizer *Tokenizer
 	deviceID  int
 }
 
-// EngineConfig represents configuration for creating an engine
-type EngineConfig struct {
-	ModelDir    string
-	Config      string
-	WeightType  string
-	DeviceID    int
-	TensorPara  int
+// InstanceEngineConfig represents configuration for creating an engine
+type InstanceEngineConfig struct {
+	ModelDir     string
+	Config       string
+	WeightType   string
+	DeviceID     int
+	TensorPara   int
 	PipelinePara int
+	// DeviceIDs assigns each rank in the TensorPara*PipelinePara group to a
+	// CUDA device. If empty, ranks are assigned DeviceID, DeviceID+1, ...
+	DeviceIDs []int
 }
 
 // InferenceRequest represents a high-level inference request
@@ -45,8 +50,8 @@ type InferenceResult struct {
 	SessionID    uint64
 }
 
-// NewEngine creates a new TurboMind inference engine
-func NewEngine(config *EngineConfig) (*Engine, error) {
+// NewInstanceEngine creates a new TurboMind inference engine
+func NewInstanceEngine(config *InstanceEngineConfig) (*InstanceEngine, error) {
 	if config == nil {
 		return nil, errors.New("config cannot be nil")
 	}
@@ -57,13 +62,31 @@ func NewEngine(config *EngineConfig) (*Engine, error) {
 		return nil, fmt.Errorf("failed to create model: %v", err)
 	}
 	
-	// Create model instance
-	instance, err := model.CreateInstance(config.DeviceID)
+	// Create one model instance per rank in the tensor/pipeline-parallel
+	// group; TensorPara=PipelinePara=1 is a single rank on DeviceID.
+	tpSize := config.TensorPara
+	if tpSize <= 0 {
+		tpSize = 1
+	}
+	ppSize := config.PipelinePara
+	if ppSize <= 0 {
+		ppSize = 1
+	}
+
+	deviceIDs := config.DeviceIDs
+	if len(deviceIDs) == 0 {
+		deviceIDs = make([]int, tpSize*ppSize)
+		for i := range deviceIDs {
+			deviceIDs[i] = config.DeviceID + i
+		}
+	}
+
+	group, err := NewInstanceGroup(model, tpSize, ppSize, deviceIDs)
 	if err != nil {
 		model.Close()
-		return nil, fmt.Errorf("failed to create model instance: %v", err)
+		return nil, fmt.Errorf("failed to create instance group: %v", err)
 	}
-	
+
 	// Create tokenizer (optional)
 	var tokenizer *Tokenizer
 	if config.ModelDir != "" {
@@ -75,23 +98,23 @@ func NewEngine(config *EngineConfig) (*Engine, error) {
 		}
 	}
 	
-	return &Engine{
+	return &InstanceEngine{
 		model:     model,
-		instance:  instance,
+		group:     group,
 		tokenizer: tokenizer,
 		deviceID:  config.DeviceID,
 	}, nil
 }
 
 // Close closes the engine and releases resources
-func (e *Engine) Close() {
+func (e *InstanceEngine) Close() {
 	if e.tokenizer != nil {
 		e.tokenizer.Close()
 		e.tokenizer = nil
 	}
-	if e.instance != nil {
-		e.instance.Close()
-		e.instance = nil
+	if e.group != nil {
+		e.group.Close()
+		e.group = nil
 	}
 	if e.model != nil {
 		e.model.Close()
@@ -100,8 +123,8 @@ func (e *Engine) Close() {
 }
 
 // Generate performs text generation
-func (e *Engine) Generate(request *InferenceRequest) (*InferenceResult, error) {
-	if e.instance == nil {
+func (e *InstanceEngine) Generate(request *InferenceRequest) (*InferenceResult, error) {
+	if e.group == nil {
 		return nil, errors.New("engine is closed")
 	}
 	
@@ -154,28 +177,171 @@ func (e *Engine) Generate(request *InferenceRequest) (*InferenceResult, error) {
 	genConfig := e.createGenerationConfig(request)
 	
 	// Perform inference
-	result, err := e.instance.Forward(tensorMap, session, genConfig, request.StreamOutput)
+	result, err := e.group.Forward(tensorMap, session, genConfig, request.StreamOutput)
 	if err != nil {
 		return nil, fmt.Errorf("inference failed: %v", err)
 	}
 	defer result.Close()
 	
 	// Extract output
-	outputText, err := e.extractOutput(result)
+	outputText, tokensUsed, err := e.extractOutput(result)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract output: %v", err)
 	}
-	
+
 	return &InferenceResult{
-		Text:      outputText,
-		TokensUsed: len(inputTokens), // Simplified
-		Finished:  true,
-		SessionID: request.SessionID,
+		Text:       outputText,
+		TokensUsed: tokensUsed,
+		Finished:   true,
+		SessionID:  request.SessionID,
 	}, nil
 }
 
+// GenerateBatch tokenizes every request and submits them as one
+// continuous-batching ForwardBatch call instead of looping over Generate,
+// decoding each request's own output and returning results in request
+// order.
+func (e *InstanceEngine) GenerateBatch(requests []*InferenceRequest) ([]*InferenceResult, error) {
+	if e.group == nil {
+		return nil, errors.New("engine is closed")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New("requests cannot be empty")
+	}
+
+	batchRequests := make([]*BatchRequest, len(requests))
+	for i, request := range requests {
+		batchRequests[i] = &BatchRequest{
+			Tokens:    e.tokenizePrompt(request.Prompt),
+			Session:   &Session{ID: request.SessionID, Step: 0, StartFlag: true, EndFlag: false},
+			GenConfig: e.createGenerationConfig(request),
+		}
+	}
+
+	results, err := e.group.ForwardBatch(batchRequests)
+	if err != nil {
+		return nil, fmt.Errorf("batch inference failed: %v", err)
+	}
+
+	out := make([]*InferenceResult, len(results))
+	for i, result := range results {
+		outputText, tokensUsed, err := e.extractOutput(result)
+		result.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract output for request %d: %v", i, err)
+		}
+		out[i] = &InferenceResult{
+			Text:       outputText,
+			TokensUsed: tokensUsed,
+			Finished:   true,
+			SessionID:  requests[i].SessionID,
+		}
+	}
+
+	return out, nil
+}
+
+// GenerateStream performs streaming inference, pushing an InferenceResult
+// to the returned channel for each chunk of tokens ForwardStream produces,
+// detokenizing incrementally, until the request finishes or ctx is
+// cancelled. Cancelling ctx aborts the underlying forward via
+// ModelInstance.Cancel.
+func (e *InstanceEngine) GenerateStream(ctx context.Context, request *InferenceRequest) (<-chan InferenceResult, error) {
+	if e.group == nil {
+		return nil, errors.New("engine is closed")
+	}
+	if request == nil {
+		return nil, errors.New("request cannot be nil")
+	}
+
+	inputTokens := e.tokenizePrompt(request.Prompt)
+
+	inputTensor, err := e.createInputTensor(inputTokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create input tensor: %v", err)
+	}
+
+	tensorMap := NewTensorMap()
+	if tensorMap == nil {
+		inputTensor.Close()
+		return nil, errors.New("failed to create tensor map")
+	}
+	if err := tensorMap.Set("input_ids", inputTensor); err != nil {
+		inputTensor.Close()
+		tensorMap.Close()
+		return nil, fmt.Errorf("failed to set input tensor: %v", err)
+	}
+	inputTensor.Close()
+
+	seqLenTensor, err := e.createSequenceLengthTensor(len(inputTokens))
+	if err != nil {
+		tensorMap.Close()
+		return nil, fmt.Errorf("failed to create sequence length tensor: %v", err)
+	}
+	if err := tensorMap.Set("sequence_length", seqLenTensor); err != nil {
+		seqLenTensor.Close()
+		tensorMap.Close()
+		return nil, fmt.Errorf("failed to set sequence length tensor: %v", err)
+	}
+	seqLenTensor.Close()
+
+	session := &Session{ID: request.SessionID, Step: 0, StartFlag: true, EndFlag: false}
+	genConfig := e.createGenerationConfig(request)
+
+	chunks, err := e.group.ForwardStream(ctx, tensorMap, session, genConfig)
+	if err != nil {
+		tensorMap.Close()
+		return nil, fmt.Errorf("streaming inference failed: %v", err)
+	}
+
+	out := make(chan InferenceResult)
+	go e.streamResults(ctx, request.SessionID, tensorMap, chunks, out)
+	return out, nil
+}
+
+// streamResults drains chunks, detokenizing each chunk's new tokens and
+// pushing an InferenceResult for it, until chunks closes or ctx is
+// cancelled. It owns tensorMap and out, closing both before returning.
+func (e *InstanceEngine) streamResults(ctx context.Context, sessionID uint64, tensorMap *TensorMap, chunks <-chan *StreamChunk, out chan<- InferenceResult) {
+	defer close(out)
+	defer tensorMap.Close()
+
+	var tokensSeen int
+	for chunk := range chunks {
+		if chunk.Err != nil {
+			sendResult(ctx, out, InferenceResult{SessionID: sessionID, Finished: true})
+			return
+		}
+
+		tokensSeen += len(chunk.Tokens)
+		result := InferenceResult{
+			Text:       e.detokenize(chunk.Tokens),
+			TokensUsed: tokensSeen,
+			Finished:   chunk.Finished,
+			SessionID:  sessionID,
+		}
+		if !sendResult(ctx, out, result) {
+			return
+		}
+		if chunk.Finished {
+			return
+		}
+	}
+}
+
+// sendResult delivers result to out, returning false if ctx was cancelled
+// first instead.
+func sendResult(ctx context.Context, out chan<- InferenceResult, result InferenceResult) bool {
+	select {
+	case out <- result:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // GetModelInfo returns information about the model
-func (e *Engine) GetModelInfo() map[string]interface{} {
+func (e *InstanceEngine) GetModelInfo() map[string]interface{} {
 	if e.model == nil {
 		return nil
 	}
@@ -188,22 +354,22 @@ func (e *Engine) GetModelInfo() map[string]interface{} {
 }
 
 // EndSession ends an inference session
-func (e *Engine) EndSession(sessionID uint64) {
-	if e.instance != nil {
-		e.instance.EndSession(sessionID)
+func (e *InstanceEngine) EndSession(sessionID uint64) {
+	if e.group != nil {
+		e.group.EndSession(sessionID)
 	}
 }
 
 // Cancel cancels current inference
-func (e *Engine) Cancel() {
-	if e.instance != nil {
-		e.instance.Cancel()
+func (e *InstanceEngine) Cancel() {
+	if e.group != nil {
+		e.group.Cancel()
 	}
 }
 
 // Helper methods
 
-func (e *Engine) tokenizePrompt(prompt string) []int32 {
+func (e *InstanceEngine) tokenizePrompt(prompt string) []int32 {
 	if e.tokenizer != nil {
 		// Use real tokenizer
 		tokens, err := e.tokenizer.EncodeWithBOS(prompt)
@@ -230,7 +396,7 @@ func (e *Engine) tokenizePrompt(prompt string) []int32 {
 	return tokens
 }
 
-func (e *Engine) detokenize(tokens []int32) string {
+func (e *InstanceEngine) detokenize(tokens []int32) string {
 	if e.tokenizer != nil {
 		// Use real tokenizer
 		intTokens := make([]int, len(tokens))
@@ -261,7 +427,7 @@ func (e *Engine) detokenize(tokens []int32) string {
 	return result
 }
 
-func (e *Engine) createInputTensor(tokens []int32) (*Tensor, error) {
+func (e *InstanceEngine) createInputTensor(tokens []int32) (*Tensor, error) {
 	// Allocate memory for tokens
 	data := make([]int32, len(tokens))
 	copy(data, tokens)
@@ -271,14 +437,14 @@ func (e *Engine) createInputTensor(tokens []int32) (*Tensor, error) {
 	return NewTensor(unsafe.Pointer(&data[0]), shape, TypeInt32, MemoryGPU, e.deviceID)
 }
 
-func (e *Engine) createSequenceLengthTensor(length int) (*Tensor, error) {
+func (e *InstanceEngine) createSequenceLengthTensor(length int) (*Tensor, error) {
 	// Create sequence length tensor
 	data := []int32{int32(length)}
 	shape := []int64{1}
 	return NewTensor(unsafe.Pointer(&data[0]), shape, TypeInt32, MemoryGPU, e.deviceID)
 }
 
-func (e *Engine) createGenerationConfig(request *InferenceRequest) *GenerationConfig {
+func (e *InstanceEngine) createGenerationConfig(request *InferenceRequest) *GenerationConfig {
 	config := DefaultGenerationConfig()
 	
 	if request.MaxTokens > 0 {
@@ -309,32 +475,67 @@ func (e *Engine) createGenerationConfig(request *InferenceRequest) *GenerationCo
 	return config
 }
 
-func (e *Engine) extractOutput(result *ForwardResult) (string, error) {
-	// This is a simplified implementation
-	// In real implementation, you'd extract the output_ids tensor and detokenize
-	
-	// Generate different text based on seq_len (which varies by session)
-	mockResponses := []string{
-		"Hello! I'm doing well, thank you for asking.",
-		"The capital of France is Paris.",
-		"Quantum computing uses quantum mechanics to process information.",
-		"AI brings wisdom to code, intelligence to data, hope to humanity's future.",
-		"Generated response with varied content based on session.",
+// extractOutput pulls output_ids out of result's output tensors, trims it
+// to sequence_length to drop any batch padding, and detokenizes what's
+// left. It returns the decoded text along with the real output token
+// count, for callers that report TokensUsed.
+func (e *InstanceEngine) extractOutput(result *ForwardResult) (string, int, error) {
+	tensors, err := result.OutputTensors()
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get output tensors: %v", err)
 	}
-	
-	// Use seq_len to pick different responses
-	responseIndex := (result.SeqLen / 10) % len(mockResponses)
-	if responseIndex >= len(mockResponses) {
-		responseIndex = len(mockResponses) - 1
+
+	outputIDs, err := tensors.Get("output_ids")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get output_ids tensor: %v", err)
+	}
+	defer outputIDs.Close()
+
+	raw, err := outputIDs.CopyToHost()
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to copy output_ids to host: %v", err)
+	}
+	tokens := bytesToTokens(raw, outputIDs.DataType())
+
+	if seqLenTensor, err := tensors.Get("sequence_length"); err == nil {
+		defer seqLenTensor.Close()
+		if raw, err := seqLenTensor.CopyToHost(); err == nil {
+			if lengths := bytesToTokens(raw, seqLenTensor.DataType()); len(lengths) > 0 {
+				if n := int(lengths[0]); n >= 0 && n <= len(tokens) {
+					tokens = tokens[:n]
+				}
+			}
+		}
+	}
+
+	return e.detokenize(tokens), len(tokens), nil
+}
+
+// bytesToTokens reinterprets raw as a slice of token ids according to
+// dtype, the same int32/int64 layouts TurboMind uses for output_ids and
+// sequence_length.
+func bytesToTokens(raw []byte, dtype DataType) []int32 {
+	switch dtype {
+	case TypeInt64:
+		tokens := make([]int32, len(raw)/8)
+		for i := range tokens {
+			tokens[i] = int32(binary.LittleEndian.Uint64(raw[i*8:]))
+		}
+		return tokens
+	default:
+		tokens := make([]int32, len(raw)/4)
+		for i := range tokens {
+			tokens[i] = int32(binary.LittleEndian.Uint32(raw[i*4:]))
+		}
+		return tokens
 	}
-	return mockResponses[responseIndex], nil
 }
 
 // Utility functions for creating engines
 
-// DefaultEngineConfig returns a default engine configuration
-func DefaultEngineConfig(modelDir string) *EngineConfig {
-	return &EngineConfig{
+// DefaultInstanceEngineConfig returns a default engine configuration
+func DefaultInstanceEngineConfig(modelDir string) *InstanceEngineConfig {
+	return &InstanceEngineConfig{
 		ModelDir:     modelDir,
 		Config:       "",
 		WeightType:   "half",
@@ -344,9 +545,9 @@ func DefaultEngineConfig(modelDir string) *EngineConfig {
 	}
 }
 
-// EngineConfigFromJSON creates an engine config from JSON
-func EngineConfigFromJSON(jsonData []byte) (*EngineConfig, error) {
-	var config EngineConfig
+// InstanceEngineConfigFromJSON creates an engine config from JSON
+func InstanceEngineConfigFromJSON(jsonData []byte) (*InstanceEngineConfig, error) {
+	var config InstanceEngineConfig
 	if err := json.Unmarshal(jsonData, &config); err != nil {
 		return nil, fmt.Errorf("failed to parse JSON config: %v", err)
 	}
@@ -354,6 +555,6 @@ func EngineConfigFromJSON(jsonData []byte) (*EngineConfig, error) {
 }
 
 // ToJSON converts engine config to JSON
-func (c *EngineConfig) ToJSON() ([]byte, error) {
+func (c *InstanceEngineConfig) ToJSON() ([]byte, error) {
 	return json.Marshal(c)
 }
\ No newline at end of file
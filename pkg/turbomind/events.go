@@ -0,0 +1,252 @@
+package turbomind
+
+import "sync"
+
+// CancelFunc unsubscribes a Subscribe/SubscribeAll call. It closes the
+// subscriber's channel, so a range over that channel terminates whether it
+// was cancelled explicitly or the Engine was closed first. Safe to call
+// more than once and from any goroutine.
+type CancelFunc func()
+
+// eventBus fans out the ResponseData snapshots streamResponses publishes
+// for each request to any number of per-request subscribers plus a
+// firehose of every request, in the spirit of Tendermint's libs/events
+// "fire events / cached events" pattern: each request's snapshots are
+// cached only while the request is still in flight, so a subscriber that
+// calls Engine.Subscribe after generation has already started (but before
+// it finishes) still replays the full history so far. A finished request
+// with no subscriber drops its cache immediately rather than holding it
+// forever on the chance something subscribes later - streamResponses
+// publishes for every Generate/GenerateStream call whether or not anyone
+// ever subscribes, so the common no-Subscribe path would otherwise leak.
+type eventBus struct {
+	mu     sync.Mutex
+	closed bool
+	topics map[int64]*eventTopic
+	all    map[int]chan ResponseData
+	nextID int
+}
+
+// eventTopic holds the cached snapshot history and live subscribers for
+// one request id.
+type eventTopic struct {
+	cache    []ResponseData
+	subs     map[int]chan ResponseData
+	nextID   int
+	finished bool
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{topics: make(map[int64]*eventTopic), all: make(map[int]chan ResponseData)}
+}
+
+// publish records resp in requestID's topic cache and delivers it to every
+// subscriber of that request and of SubscribeAll. A subscriber whose
+// channel is full has resp dropped rather than blocking generation; it
+// can still get the snapshot from the topic cache's replay if it
+// resubscribes while the request is still in flight.
+func (b *eventBus) publish(requestID int64, resp ResponseData) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+
+	topic := b.topics[requestID]
+	if topic == nil {
+		topic = &eventTopic{subs: make(map[int]chan ResponseData)}
+		b.topics[requestID] = topic
+	}
+	topic.cache = append(topic.cache, resp)
+	topic.finished = resp.Finished
+
+	for _, ch := range topic.subs {
+		trySend(ch, resp)
+	}
+	for _, ch := range b.all {
+		trySend(ch, resp)
+	}
+
+	// Nobody ever subscribed and the request is done, so nothing will ever
+	// call the subscribe-cancel path that would otherwise clear this topic -
+	// drop it now instead of caching a finished request's history forever.
+	if topic.finished && len(topic.subs) == 0 {
+		delete(b.topics, requestID)
+	}
+}
+
+func trySend(ch chan ResponseData, resp ResponseData) {
+	select {
+	case ch <- resp:
+	default:
+	}
+}
+
+// subscribe registers ch under requestID's topic, replaying the cached
+// history first so ch sees the full stream regardless of when it
+// subscribed. Returns false without registering ch if the bus is closed.
+func (b *eventBus) subscribe(requestID int64, ch chan ResponseData) (CancelFunc, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return func() {}, false
+	}
+
+	topic := b.topics[requestID]
+	if topic == nil {
+		topic = &eventTopic{subs: make(map[int]chan ResponseData)}
+		b.topics[requestID] = topic
+	}
+	for _, resp := range topic.cache {
+		trySend(ch, resp)
+	}
+
+	id := topic.nextID
+	topic.nextID++
+	topic.subs[id] = ch
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if t := b.topics[requestID]; t != nil {
+				delete(t.subs, id)
+				close(ch)
+				if t.finished && len(t.subs) == 0 {
+					delete(b.topics, requestID)
+				}
+			}
+		})
+	}, true
+}
+
+// subscribeAll registers ch as a firehose of every topic's future
+// snapshots; it has no cached replay of its own.
+func (b *eventBus) subscribeAll(ch chan ResponseData) CancelFunc {
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	if b.closed {
+		b.mu.Unlock()
+		close(ch)
+		return func() {}
+	}
+	b.all[id] = ch
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if _, ok := b.all[id]; ok {
+				delete(b.all, id)
+				close(ch)
+			}
+		})
+	}
+}
+
+// close closes every live subscriber channel and stops accepting new
+// publishes or subscriptions. Engine.Close calls this so no goroutine is
+// left blocked reading a channel that will never receive again.
+func (b *eventBus) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for _, topic := range b.topics {
+		for _, ch := range topic.subs {
+			close(ch)
+		}
+	}
+	for _, ch := range b.all {
+		close(ch)
+	}
+	b.topics = nil
+	b.all = nil
+}
+
+// defaultSubscriberBuffer bounds how many snapshots Subscribe/SubscribeAll
+// buffer per subscriber before a slow reader starts missing new ones; the
+// topic cache used for replay is unaffected since it lives in the bus, not
+// the channel.
+const defaultSubscriberBuffer = 32
+
+// Subscribe returns a channel of ResponseData snapshots for requestID, one
+// per TokenDelta: a replay of those Generate/GenerateStream has published
+// so far, then each one published after, up to the Finished=true snapshot.
+// Delivery never blocks generation, so a snapshot that finds the channel's
+// defaultSubscriberBuffer slots full is dropped for this subscriber, during
+// replay as well. Subscribing after a request without subscribers has
+// finished yields no snapshots, since its history is no longer kept. Call
+// the returned CancelFunc to unsubscribe and release the channel; ranging
+// over the channel terminates correctly whether the caller cancels or
+// Engine.Close runs first.
+func (e *Engine) Subscribe(requestID int64) (<-chan ResponseData, CancelFunc) {
+	ch := make(chan ResponseData, defaultSubscriberBuffer)
+	cancel, ok := e.events.subscribe(requestID, ch)
+	if !ok {
+		close(ch)
+		return ch, func() {}
+	}
+	return ch, cancel
+}
+
+// SubscribeAll returns a firehose of every ResponseData snapshot published
+// across all requests, for observability and tracing sinks that want to
+// watch the whole engine rather than one request at a time. Unlike
+// Subscribe it has no cached replay: it only sees snapshots published
+// after the call.
+func (e *Engine) SubscribeAll() (<-chan ResponseData, CancelFunc) {
+	ch := make(chan ResponseData, defaultSubscriberBuffer)
+	return ch, e.events.subscribeAll(ch)
+}
+
+// EventCache batches ResponseData snapshots added by Add until Flush
+// drains them, for sinks (logging, tracing) that want to write in batches
+// instead of handling one snapshot at a time. Pair it with Collect to feed
+// it from a Subscribe/SubscribeAll channel.
+type EventCache struct {
+	mu     sync.Mutex
+	events []ResponseData
+}
+
+// NewEventCache returns an empty EventCache.
+func NewEventCache() *EventCache {
+	return &EventCache{}
+}
+
+// Add appends resp to the cache.
+func (c *EventCache) Add(resp ResponseData) {
+	c.mu.Lock()
+	c.events = append(c.events, resp)
+	c.mu.Unlock()
+}
+
+// Flush returns every snapshot added since the last Flush and resets the
+// cache, returning nil if none were added.
+func (c *EventCache) Flush() []ResponseData {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.events) == 0 {
+		return nil
+	}
+	events := c.events
+	c.events = nil
+	return events
+}
+
+// Collect drains ch into cache until ch is closed, in its own goroutine,
+// so callers can pair it with Subscribe/SubscribeAll and fire-and-forget
+// it.
+func Collect(ch <-chan ResponseData, cache *EventCache) {
+	go func() {
+		for resp := range ch {
+			cache.Add(resp)
+		}
+	}()
+}
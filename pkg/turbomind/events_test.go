@@ -0,0 +1,114 @@
+package turbomind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBusPublishReplaysCacheToLateSubscriber(t *testing.T) {
+	bus := newEventBus()
+
+	bus.publish(1, ResponseData{Text: "a"})
+
+	// Subscribing after "a" but before the request finishes must still see
+	// "a" replayed, then "b" live as it's published.
+	ch := make(chan ResponseData, 4)
+	cancel, ok := bus.subscribe(1, ch)
+	require.True(t, ok)
+	defer cancel()
+
+	bus.publish(1, ResponseData{Text: "b", Finished: true})
+
+	assert.Equal(t, ResponseData{Text: "a"}, <-ch)
+	assert.Equal(t, ResponseData{Text: "b", Finished: true}, <-ch)
+}
+
+func TestEventBusPublishDropsFinishedTopicWithNoSubscribers(t *testing.T) {
+	bus := newEventBus()
+
+	bus.publish(6, ResponseData{Text: "a"})
+	bus.publish(6, ResponseData{Text: "b", Finished: true})
+
+	bus.mu.Lock()
+	_, ok := bus.topics[6]
+	bus.mu.Unlock()
+	assert.False(t, ok, "a finished topic with no subscribers must not be cached forever")
+}
+
+func TestEventBusPublishDeliversToLiveSubscriberAndFirehose(t *testing.T) {
+	bus := newEventBus()
+
+	topicCh := make(chan ResponseData, 4)
+	cancelTopic, ok := bus.subscribe(2, topicCh)
+	require.True(t, ok)
+	defer cancelTopic()
+
+	allCh := make(chan ResponseData, 4)
+	cancelAll := bus.subscribeAll(allCh)
+	defer cancelAll()
+
+	bus.publish(2, ResponseData{Text: "hello"})
+
+	assert.Equal(t, ResponseData{Text: "hello"}, <-topicCh)
+	assert.Equal(t, ResponseData{Text: "hello"}, <-allCh)
+}
+
+func TestEventBusCancelUnsubscribesAndClosesChannel(t *testing.T) {
+	bus := newEventBus()
+
+	ch := make(chan ResponseData, 1)
+	cancel, ok := bus.subscribe(3, ch)
+	require.True(t, ok)
+
+	cancel()
+	cancel() // must be safe to call twice
+
+	_, open := <-ch
+	assert.False(t, open)
+
+	// A publish after cancel must not panic even though the subscriber is gone.
+	bus.publish(3, ResponseData{Text: "after cancel"})
+}
+
+func TestEventBusCloseRejectsFurtherSubscribes(t *testing.T) {
+	bus := newEventBus()
+	bus.close()
+	bus.close() // must be safe to call twice
+
+	ch := make(chan ResponseData, 1)
+	_, ok := bus.subscribe(4, ch)
+	assert.False(t, ok)
+
+	allCh := make(chan ResponseData, 1)
+	bus.subscribeAll(allCh)
+	_, open := <-allCh
+	assert.False(t, open, "subscribeAll on a closed bus must return an already-closed channel")
+}
+
+func TestEventBusCloseClosesLiveSubscribers(t *testing.T) {
+	bus := newEventBus()
+
+	ch := make(chan ResponseData, 1)
+	_, ok := bus.subscribe(5, ch)
+	require.True(t, ok)
+
+	bus.close()
+
+	_, open := <-ch
+	assert.False(t, open)
+}
+
+func TestEventCacheAddAndFlush(t *testing.T) {
+	cache := NewEventCache()
+
+	assert.Nil(t, cache.Flush(), "flushing an empty cache returns nil")
+
+	cache.Add(ResponseData{Text: "one"})
+	cache.Add(ResponseData{Text: "two"})
+
+	events := cache.Flush()
+	assert.Equal(t, []ResponseData{{Text: "one"}, {Text: "two"}}, events)
+	assert.Nil(t, cache.Flush(), "flush drains the cache")
+}
@@ -0,0 +1,3 @@
+package grpcbackend
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative turbomind.proto
@@ -0,0 +1,196 @@
+// Package grpcbackend exposes a turbomind.Engine over gRPC, using the
+// service defined in turbomind.proto. The grpcbackendpb package this file
+// depends on is generated from that file; run `go generate ./...` to
+// regenerate it after editing the .proto.
+package grpcbackend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+	"github.com/xianml/turbomind-go/pkg/turbomind/grpcbackend/grpcbackendpb"
+)
+
+// Server implements grpcbackendpb.TurboMindServiceServer against a live
+// engine and tokenizer. The zero value is not usable; construct one with
+// NewServer.
+type Server struct {
+	grpcbackendpb.UnimplementedTurboMindServiceServer
+
+	// mu guards engine/tokenizer/config: LoadModel can swap engine and
+	// config in from any gRPC goroutine while Predict/PredictStream/Health/
+	// Status read them from others. LoadModel does not replace the
+	// tokenizer.
+	mu        sync.RWMutex
+	engine    *turbomind.Engine
+	tokenizer *turbomind.Tokenizer
+	config    turbomind.Config
+
+	requestsServed int64
+}
+
+// NewServer wraps an already-initialized engine and tokenizer.
+func NewServer(engine *turbomind.Engine, tokenizer *turbomind.Tokenizer, config turbomind.Config) *Server {
+	return &Server{engine: engine, tokenizer: tokenizer, config: config}
+}
+
+// Health reports whether a model is currently loaded.
+func (s *Server) Health(ctx context.Context, req *grpcbackendpb.HealthRequest) (*grpcbackendpb.HealthReply, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &grpcbackendpb.HealthReply{Ready: s.engine != nil}, nil
+}
+
+// LoadModel replaces the current engine with one built from req, allowing
+// the server to be re-parented to a new model or parallelism degree
+// without a restart. The previous engine is closed once the new one is in
+// place so in-flight calls that already grabbed it can finish.
+func (s *Server) LoadModel(ctx context.Context, req *grpcbackendpb.LoadModelRequest) (*grpcbackendpb.LoadModelReply, error) {
+	config := turbomind.Config{
+		ModelPath:           req.ModelPath,
+		ModelFormat:         req.ModelFormat,
+		TP:                  int(req.Tp),
+		SessionLen:          int(req.SessionLen),
+		MaxBatchSize:        int(req.MaxBatchSize),
+		QuantPolicy:         int(req.QuantPolicy),
+		CacheMaxEntryCount:  int(req.CacheMaxEntryCount),
+		EnablePrefixCaching: req.EnablePrefixCaching,
+		RopeScalingFactor:   req.RopeScalingFactor,
+		RopeScalingType:     int(req.RopeScalingType),
+	}
+
+	engine, err := turbomind.NewEngine(config)
+	if err != nil {
+		return &grpcbackendpb.LoadModelReply{Ok: false, Error: err.Error()}, nil
+	}
+
+	s.mu.Lock()
+	old := s.engine
+	s.engine = engine
+	s.config = config
+	s.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	return &grpcbackendpb.LoadModelReply{Ok: true}, nil
+}
+
+// Predict runs generation to completion and returns the full reply.
+func (s *Server) Predict(ctx context.Context, req *grpcbackendpb.PredictRequest) (*grpcbackendpb.Reply, error) {
+	s.mu.RLock()
+	engine := s.engine
+	s.mu.RUnlock()
+	if engine == nil {
+		return nil, fmt.Errorf("grpcbackend: no model loaded")
+	}
+
+	resp, err := engine.Generate(s.toRequestParams(req))
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt64(&s.requestsServed, 1)
+
+	if resp.ErrorCode != 0 {
+		return nil, fmt.Errorf("grpcbackend: generate failed: %s", resp.ErrorMessage)
+	}
+
+	return &grpcbackendpb.Reply{
+		Message:          resp.Text,
+		Tokens:           int32(resp.OutputTokens),
+		PromptTokens:     int32(resp.InputTokens),
+		CompletionTokens: int32(resp.OutputTokens),
+		FinishReason:     "stop",
+	}, nil
+}
+
+// PredictStream streams one Reply per TokenDelta from GenerateStream, i.e.
+// per new increment of generated text, which may span several tokens.
+func (s *Server) PredictStream(req *grpcbackendpb.PredictRequest, stream grpcbackendpb.TurboMindService_PredictStreamServer) error {
+	s.mu.RLock()
+	engine := s.engine
+	s.mu.RUnlock()
+	if engine == nil {
+		return fmt.Errorf("grpcbackend: no model loaded")
+	}
+
+	deltas, err := engine.GenerateStream(stream.Context(), s.toRequestParams(req))
+	if err != nil {
+		return err
+	}
+	atomic.AddInt64(&s.requestsServed, 1)
+
+	for delta := range deltas {
+		reply := &grpcbackendpb.Reply{
+			Message:      delta.Text,
+			FinishReason: delta.FinishReason,
+		}
+		if delta.Usage != nil {
+			reply.PromptTokens = int32(delta.Usage.PromptTokens)
+			reply.CompletionTokens = int32(delta.Usage.CompletionTokens)
+			reply.Tokens = int32(delta.Usage.CompletionTokens)
+		}
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TokenizeString encodes text without running generation.
+func (s *Server) TokenizeString(ctx context.Context, req *grpcbackendpb.TokenizeRequest) (*grpcbackendpb.TokenizeReply, error) {
+	s.mu.RLock()
+	tokenizer := s.tokenizer
+	s.mu.RUnlock()
+	if tokenizer == nil {
+		return nil, fmt.Errorf("grpcbackend: no tokenizer loaded")
+	}
+
+	tokens, err := tokenizer.Encode(req.Text, req.AddBos)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := make([]int32, len(tokens))
+	for i, tok := range tokens {
+		reply[i] = int32(tok)
+	}
+	return &grpcbackendpb.TokenizeReply{Tokens: reply}, nil
+}
+
+// Status reports engine-level counters for monitoring.
+func (s *Server) Status(ctx context.Context, req *grpcbackendpb.StatusRequest) (*grpcbackendpb.StatusReply, error) {
+	s.mu.RLock()
+	loaded := s.engine != nil
+	s.mu.RUnlock()
+	return &grpcbackendpb.StatusReply{
+		ModelLoaded:    loaded,
+		RequestsServed: atomic.LoadInt64(&s.requestsServed),
+	}, nil
+}
+
+func (s *Server) toRequestParams(req *grpcbackendpb.PredictRequest) turbomind.RequestParams {
+	stopWords := "[]"
+	if len(req.StopWords) > 0 {
+		if encoded, err := json.Marshal(req.StopWords); err == nil {
+			stopWords = string(encoded)
+		}
+	}
+
+	return turbomind.RequestParams{
+		RequestID:         req.RequestId,
+		Prompt:            req.Prompt,
+		MaxNewTokens:      int(req.MaxNewTokens),
+		Temperature:       req.Temperature,
+		TopP:              req.TopP,
+		TopK:              req.TopK,
+		RepetitionPenalty: int(req.RepetitionPenalty),
+		PresencePenalty:   req.PresencePenalty,
+		FrequencyPenalty:  req.FrequencyPenalty,
+		StopWords:         stopWords,
+	}
+}
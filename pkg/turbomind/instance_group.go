@@ -0,0 +1,140 @@
+package turbomind
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// InstanceGroup fans a single Forward call across TensorPara * PipelinePara
+// ModelInstances, one per rank, mirroring how NCCL-backed distributed
+// builds (Paddle, TensorFlow) shard a single op across ranks and join on
+// the result: each rank's Forward runs on its own OS thread pinned to its
+// CUDA device via runtime.LockOSThread + SetDevice, and
+// InstanceGroup.Forward blocks until every rank has returned before
+// handing back rank 0's ForwardResult, since turbomind keeps the
+// assembled output there.
+type InstanceGroup struct {
+	instances []*ModelInstance
+	deviceIDs []int
+	tpSize    int
+	ppSize    int
+}
+
+// NewInstanceGroup creates one ModelInstance per rank in a tpSize x ppSize
+// grid, rank i pinned to deviceIDs[i]; len(deviceIDs) must equal
+// tpSize*ppSize. Each CreateInstance call joins the model's NCCL
+// communicator group for that rank.
+func NewInstanceGroup(model *Model, tpSize, ppSize int, deviceIDs []int) (*InstanceGroup, error) {
+	if tpSize <= 0 || ppSize <= 0 {
+		return nil, errors.New("turbomind: tpSize and ppSize must be positive")
+	}
+	worldSize := tpSize * ppSize
+	if len(deviceIDs) != worldSize {
+		return nil, fmt.Errorf("turbomind: need %d device ids for tp=%d pp=%d, got %d", worldSize, tpSize, ppSize, len(deviceIDs))
+	}
+
+	instances := make([]*ModelInstance, 0, worldSize)
+	for rank, deviceID := range deviceIDs {
+		instance, err := model.CreateInstance(deviceID, rank)
+		if err != nil {
+			for _, created := range instances {
+				created.Close()
+			}
+			return nil, fmt.Errorf("turbomind: create instance for rank %d: %w", rank, err)
+		}
+		instances = append(instances, instance)
+	}
+
+	return &InstanceGroup{instances: instances, deviceIDs: deviceIDs, tpSize: tpSize, ppSize: ppSize}, nil
+}
+
+// Forward runs Forward on every rank concurrently and returns rank 0's
+// ForwardResult once every rank has completed; every other rank's result
+// is closed once joined. If any rank errors, Forward closes every
+// successful result and returns the first error encountered.
+func (g *InstanceGroup) Forward(inputTensors *TensorMap, session *Session, genConfig *GenerationConfig, streamOutput bool) (*ForwardResult, error) {
+	if len(g.instances) == 0 {
+		return nil, errors.New("turbomind: instance group is closed")
+	}
+
+	results := make([]*ForwardResult, len(g.instances))
+	errs := make([]error, len(g.instances))
+
+	var wg sync.WaitGroup
+	for rank, instance := range g.instances {
+		wg.Add(1)
+		go func(rank int, instance *ModelInstance, deviceID int) {
+			defer wg.Done()
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+
+			SetDevice(deviceID)
+			results[rank], errs[rank] = instance.Forward(inputTensors, session, genConfig, streamOutput)
+		}(rank, instance, g.deviceIDs[rank])
+	}
+	wg.Wait()
+
+	for rank, err := range errs {
+		if err != nil {
+			for i, result := range results {
+				if i != rank && result != nil {
+					result.Close()
+				}
+			}
+			return nil, fmt.Errorf("turbomind: rank %d forward: %w", rank, err)
+		}
+	}
+
+	for i := 1; i < len(results); i++ {
+		if results[i] != nil {
+			results[i].Close()
+		}
+	}
+	return results[0], nil
+}
+
+// ForwardStream starts streaming inference on rank 0 and returns its
+// StreamChunk channel. Only rank 0 streams: unlike Forward, the other ranks
+// of a tp/pp > 1 group are not run and no results are joined across ranks.
+func (g *InstanceGroup) ForwardStream(ctx context.Context, inputTensors *TensorMap, session *Session, genConfig *GenerationConfig) (<-chan *StreamChunk, error) {
+	if len(g.instances) == 0 {
+		return nil, errors.New("turbomind: instance group is closed")
+	}
+	return g.instances[0].ForwardStream(ctx, inputTensors, session, genConfig)
+}
+
+// ForwardBatch runs rank 0's ForwardBatch and returns its results. Only
+// rank 0 batches today, the same single-rank limitation ForwardStream
+// documents: a tp/pp > 1 group still needs ForwardBatch fanned across
+// every rank and joined the way Forward does for a single request.
+func (g *InstanceGroup) ForwardBatch(requests []*BatchRequest) ([]*ForwardResult, error) {
+	if len(g.instances) == 0 {
+		return nil, errors.New("turbomind: instance group is closed")
+	}
+	return g.instances[0].ForwardBatch(requests)
+}
+
+// EndSession ends sessionID on every rank.
+func (g *InstanceGroup) EndSession(sessionID uint64) {
+	for _, instance := range g.instances {
+		instance.EndSession(sessionID)
+	}
+}
+
+// Cancel cancels the current request on every rank.
+func (g *InstanceGroup) Cancel() {
+	for _, instance := range g.instances {
+		instance.Cancel()
+	}
+}
+
+// Close destroys every rank's ModelInstance.
+func (g *InstanceGroup) Close() {
+	for _, instance := range g.instances {
+		instance.Close()
+	}
+	g.instances = nil
+}
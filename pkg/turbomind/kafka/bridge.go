@@ -0,0 +1,236 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	kafkago "github.com/segmentio/kafka-go"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+// Bridge reads prompts off Kafka, dispatches them to an Engine, and
+// publishes streamed tokens and the final response back to Kafka. Create
+// one with NewBridge, drive it with Run, and call Close before closing the
+// underlying Engine so in-flight requests get to finish.
+type Bridge struct {
+	engine *turbomind.Engine
+	cfg    Config
+	reader *kafkago.Reader
+	writer *kafkago.Writer
+
+	sem chan struct{}
+	wg  sync.WaitGroup
+
+	// drainCtx is handleMessage's context. It is independent of Run's ctx
+	// so cancelling Run (the documented way to stop consuming) doesn't
+	// abort requests already dispatched; only Close cancels it, and only
+	// after every dispatched request has finished.
+	drainCtx    context.Context
+	drainCancel context.CancelFunc
+}
+
+// NewBridge resolves Config.Topics (expanding any regexp entries against
+// the cluster's topic list) and opens the consumer group and producer
+// Bridge will use.
+func NewBridge(engine *turbomind.Engine, cfg Config) (*Bridge, error) {
+	if cfg.MaxInFlight <= 0 {
+		cfg.MaxInFlight = 1
+	}
+
+	topics := cfg.Topics
+	if hasPattern(cfg.Topics) {
+		available, err := discoverTopics(cfg.Brokers)
+		if err != nil {
+			return nil, fmt.Errorf("kafka: discover topics: %w", err)
+		}
+		topics, err = resolveTopics(cfg.Topics, available)
+		if err != nil {
+			return nil, fmt.Errorf("kafka: resolve topics: %w", err)
+		}
+	}
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("kafka: no input topics resolved from %v", cfg.Topics)
+	}
+
+	reader := kafkago.NewReader(kafkago.ReaderConfig{
+		Brokers:     cfg.Brokers,
+		GroupID:     cfg.GroupID,
+		GroupTopics: topics,
+	})
+	writer := &kafkago.Writer{
+		Addr:     kafkago.TCP(cfg.Brokers...),
+		Topic:    cfg.OutputTopic,
+		Balancer: &kafkago.Hash{},
+	}
+
+	drainCtx, drainCancel := context.WithCancel(context.Background())
+	return &Bridge{
+		engine:      engine,
+		cfg:         cfg,
+		reader:      reader,
+		writer:      writer,
+		sem:         make(chan struct{}, cfg.MaxInFlight),
+		drainCtx:    drainCtx,
+		drainCancel: drainCancel,
+	}, nil
+}
+
+func hasPattern(topics []string) bool {
+	for _, t := range topics {
+		if len(t) > 0 && t[0] == '^' {
+			return true
+		}
+	}
+	return false
+}
+
+func discoverTopics(brokers []string) ([]string, error) {
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("no brokers configured")
+	}
+	conn, err := kafkago.Dial("tcp", brokers[0])
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	partitions, err := conn.ReadPartitions()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(partitions))
+	var topics []string
+	for _, p := range partitions {
+		if !seen[p.Topic] {
+			seen[p.Topic] = true
+			topics = append(topics, p.Topic)
+		}
+	}
+	return topics, nil
+}
+
+// Run consumes messages until ctx is cancelled or FetchMessage returns a
+// non-context error. Each message is dispatched to the engine in its own
+// goroutine, bounded by Config.MaxInFlight in-flight requests at a time;
+// Run itself returns as soon as ctx is done, but dispatched requests keep
+// running until Close drains them.
+func (b *Bridge) Run(ctx context.Context) error {
+	for {
+		msg, err := b.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("kafka: fetch message: %w", err)
+		}
+
+		select {
+		case b.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil
+		}
+
+		b.wg.Add(1)
+		go b.handleMessage(b.drainCtx, msg)
+	}
+}
+
+// handleMessage decodes, dispatches, and streams the result for a single
+// Kafka message, committing its offset only once the request has reached
+// a terminal state (Finished=true or a terminal error). ctx is b.drainCtx,
+// not Run's ctx, so cancelling Run doesn't cut this request short.
+func (b *Bridge) handleMessage(ctx context.Context, msg kafkago.Message) {
+	defer b.wg.Done()
+	defer func() { <-b.sem }()
+
+	var req InboundRequest
+	if err := json.Unmarshal(msg.Value, &req); err != nil {
+		b.publish(ctx, msg, OutboundMessage{Finished: true, ErrorMessage: fmt.Sprintf("invalid request: %v", err)})
+		b.commit(ctx, msg)
+		return
+	}
+
+	params, err := req.toRequestParams()
+	if err != nil {
+		b.publish(ctx, msg, OutboundMessage{RequestID: req.RequestID, Finished: true, ErrorMessage: err.Error()})
+		b.commit(ctx, msg)
+		return
+	}
+
+	deltas, err := b.engine.GenerateStream(ctx, params)
+	if err != nil {
+		b.publish(ctx, msg, OutboundMessage{RequestID: req.RequestID, Finished: true, ErrorMessage: err.Error()})
+		b.commit(ctx, msg)
+		return
+	}
+
+	for delta := range deltas {
+		out := OutboundMessage{
+			RequestID:  req.RequestID,
+			Text:       delta.Text,
+			TokenIndex: delta.Index,
+		}
+		if delta.FinishReason != "" {
+			out.Finished = true
+			out.FinishReason = delta.FinishReason
+			if delta.FinishReason == "error" {
+				out.ErrorMessage = delta.Text
+			}
+		}
+		if delta.Usage != nil {
+			out.Usage = delta.Usage
+		}
+		b.publish(ctx, msg, out)
+	}
+	b.commit(ctx, msg)
+}
+
+// publish writes out to Config.OutputTopic, keyed by Config.KeyFunc (or
+// the decimal request id by default), carrying the source message's
+// topic/partition/group as headers for downstream relabeling.
+func (b *Bridge) publish(ctx context.Context, src kafkago.Message, out OutboundMessage) {
+	value, err := json.Marshal(out)
+	if err != nil {
+		return
+	}
+
+	key := []byte(strconv.FormatInt(out.RequestID, 10))
+	if b.cfg.KeyFunc != nil {
+		key = b.cfg.KeyFunc(InboundRequest{RequestID: out.RequestID})
+	}
+
+	_ = b.writer.WriteMessages(ctx, kafkago.Message{
+		Key:   key,
+		Value: value,
+		Headers: []kafkago.Header{
+			{Key: "__meta_kafka_topic", Value: []byte(src.Topic)},
+			{Key: "__meta_kafka_partition", Value: []byte(strconv.Itoa(src.Partition))},
+			{Key: "__meta_kafka_group_id", Value: []byte(b.cfg.GroupID)},
+		},
+	})
+}
+
+func (b *Bridge) commit(ctx context.Context, msg kafkago.Message) {
+	_ = b.reader.CommitMessages(ctx, msg)
+}
+
+// Close waits for in-flight requests dispatched by Run to finish, then
+// closes the underlying reader and writer. Callers should stop calling Run
+// (by cancelling its ctx) and call Close before closing the Engine, so no
+// dispatched request is left calling into a destroyed engine handle.
+func (b *Bridge) Close() error {
+	b.wg.Wait()
+	b.drainCancel()
+
+	writerErr := b.writer.Close()
+	readerErr := b.reader.Close()
+	if writerErr != nil {
+		return writerErr
+	}
+	return readerErr
+}
@@ -0,0 +1,77 @@
+// Package kafka lets a turbomind.Engine be driven by Kafka instead of
+// direct Go calls: prompts arrive as JSON messages on one or more input
+// topics and generation output is published back to an output topic,
+// mirroring the topic/consumer-group scrape target shape used elsewhere
+// in the observability ecosystem.
+package kafka
+
+import "regexp"
+
+// Config configures a Bridge.
+type Config struct {
+	// Brokers is the kafka bootstrap address list.
+	Brokers []string
+
+	// GroupID is the consumer group the Bridge joins to read Topics.
+	GroupID string
+
+	// Topics lists the input topics to consume. An entry wrapped in `^...`
+	// anchors (e.g. "^prompts.*") is matched as a regexp against the
+	// cluster's topic list instead of used literally, the same convention
+	// Promtail's Kafka scrape target uses for topic discovery.
+	Topics []string
+
+	// OutputTopic receives both streamed token deltas and the final
+	// response for every request, keyed by request_id.
+	OutputTopic string
+
+	// KeyFunc derives the outbound message key for a request. It is called
+	// with an InboundRequest that has only RequestID set. Defaults to the
+	// decimal RequestID when nil.
+	KeyFunc func(req InboundRequest) []byte
+
+	// MaxInFlight bounds how many requests the Bridge dispatches to the
+	// engine concurrently; set this to the engine's Config.MaxBatchSize so
+	// Kafka ingestion never oversubscribes the batch the engine was sized
+	// for.
+	MaxInFlight int
+}
+
+// resolveTopics expands any regexp-style entries in topics (entries
+// starting with "^") against the topics actually present on the cluster,
+// returning the literal topics to subscribe to.
+func resolveTopics(configured []string, available []string) ([]string, error) {
+	var literal []string
+	var patterns []*regexp.Regexp
+
+	for _, t := range configured {
+		if len(t) > 0 && t[0] == '^' {
+			re, err := regexp.Compile(t)
+			if err != nil {
+				return nil, err
+			}
+			patterns = append(patterns, re)
+			continue
+		}
+		literal = append(literal, t)
+	}
+
+	if len(patterns) == 0 {
+		return literal, nil
+	}
+
+	seen := make(map[string]bool, len(literal))
+	for _, t := range literal {
+		seen[t] = true
+	}
+	for _, t := range available {
+		for _, re := range patterns {
+			if re.MatchString(t) && !seen[t] {
+				literal = append(literal, t)
+				seen[t] = true
+				break
+			}
+		}
+	}
+	return literal, nil
+}
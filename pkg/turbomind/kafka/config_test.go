@@ -0,0 +1,31 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTopicsLiteralOnly(t *testing.T) {
+	literal, err := resolveTopics([]string{"prompts", "retries"}, []string{"prompts", "retries", "other"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prompts", "retries"}, literal)
+}
+
+func TestResolveTopicsExpandsRegexpAgainstAvailable(t *testing.T) {
+	literal, err := resolveTopics([]string{"^prompts.*"}, []string{"prompts.v1", "prompts.v2", "responses"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"prompts.v1", "prompts.v2"}, literal)
+}
+
+func TestResolveTopicsMixedLiteralAndRegexpNoDuplicates(t *testing.T) {
+	literal, err := resolveTopics([]string{"prompts.v1", "^prompts.*"}, []string{"prompts.v1", "prompts.v2"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"prompts.v1", "prompts.v2"}, literal)
+}
+
+func TestResolveTopicsInvalidRegexpErrors(t *testing.T) {
+	_, err := resolveTopics([]string{"^("}, nil)
+	assert.Error(t, err)
+}
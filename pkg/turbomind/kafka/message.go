@@ -0,0 +1,64 @@
+package kafka
+
+import (
+	"encoding/json"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+// InboundRequest is the JSON schema expected on the input topics: it
+// mirrors turbomind.RequestParams with a request_id field and StopWords
+// given as a plain string list instead of a pre-encoded JSON array.
+type InboundRequest struct {
+	RequestID         int64    `json:"request_id"`
+	Prompt            string   `json:"prompt"`
+	MaxNewTokens      int      `json:"max_new_tokens"`
+	Temperature       float32  `json:"temperature"`
+	TopP              float32  `json:"top_p"`
+	TopK              float32  `json:"top_k"`
+	RepetitionPenalty int      `json:"repetition_penalty"`
+	PresencePenalty   float32  `json:"presence_penalty"`
+	FrequencyPenalty  float32  `json:"frequency_penalty"`
+	StopWords         []string `json:"stop_words"`
+}
+
+// toRequestParams converts the decoded message into the engine's request
+// type, JSON-encoding StopWords into the string RequestParams expects.
+func (r InboundRequest) toRequestParams() (turbomind.RequestParams, error) {
+	stopWords := "[]"
+	if len(r.StopWords) > 0 {
+		encoded, err := json.Marshal(r.StopWords)
+		if err != nil {
+			return turbomind.RequestParams{}, err
+		}
+		stopWords = string(encoded)
+	}
+
+	return turbomind.RequestParams{
+		RequestID:         r.RequestID,
+		Prompt:            r.Prompt,
+		MaxNewTokens:      r.MaxNewTokens,
+		Temperature:       r.Temperature,
+		TopP:              r.TopP,
+		TopK:              r.TopK,
+		RepetitionPenalty: r.RepetitionPenalty,
+		PresencePenalty:   r.PresencePenalty,
+		FrequencyPenalty:  r.FrequencyPenalty,
+		Stream:            true,
+		StopWords:         stopWords,
+	}, nil
+}
+
+// OutboundMessage is published to Config.OutputTopic once per streamed
+// token delta; the last one for a request is its terminal message
+// (Finished=true, with ErrorMessage set on failure). A request that fails
+// before streaming starts gets only that terminal message.
+type OutboundMessage struct {
+	RequestID    int64            `json:"request_id"`
+	Text         string           `json:"text,omitempty"`
+	TokenIndex   int              `json:"token_index,omitempty"`
+	Finished     bool             `json:"finished"`
+	FinishReason string           `json:"finish_reason,omitempty"`
+	ErrorMessage string           `json:"error_message,omitempty"`
+	Usage        *turbomind.Usage `json:"usage,omitempty"`
+}
@@ -0,0 +1,32 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboundRequestToRequestParamsEncodesStopWords(t *testing.T) {
+	req := InboundRequest{
+		RequestID:    1,
+		Prompt:       "hello",
+		MaxNewTokens: 16,
+		StopWords:    []string{"</s>"},
+	}
+
+	params, err := req.toRequestParams()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), params.RequestID)
+	assert.Equal(t, "hello", params.Prompt)
+	assert.True(t, params.Stream, "Kafka-ingested requests always stream")
+	assert.JSONEq(t, `["</s>"]`, params.StopWords)
+}
+
+func TestInboundRequestToRequestParamsDefaultsEmptyStopWords(t *testing.T) {
+	req := InboundRequest{RequestID: 2, Prompt: "hi"}
+
+	params, err := req.toRequestParams()
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, params.StopWords)
+}
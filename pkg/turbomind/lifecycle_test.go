@@ -0,0 +1,81 @@
+package turbomind
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLifecycleConcurrentAccessAndClose spawns many goroutines racing
+// enter/leave against a single shutdown, the shape of concurrent
+// Forward/Close callers ModelInstance.life guards against. It must finish
+// without the race detector firing and without destroy running until every
+// in-flight enter/leave pair has completed.
+func TestLifecycleConcurrentAccessAndClose(t *testing.T) {
+	var l lifecycle
+	var destroyed int32
+	var inFlight int32
+	closedErr := assert.AnError
+
+	var wg sync.WaitGroup
+	const accessors = 50
+	for i := 0; i < accessors; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if err := l.enter(closedErr); err != nil {
+					return
+				}
+				assert.Zero(t, atomic.LoadInt32(&destroyed), "destroy ran while an access was in flight")
+				atomic.AddInt32(&inFlight, 1)
+				time.Sleep(time.Microsecond)
+				atomic.AddInt32(&inFlight, -1)
+				l.leave()
+			}
+		}()
+	}
+
+	time.Sleep(time.Millisecond)
+	l.shutdown(func() {
+		atomic.AddInt32(&destroyed, 1)
+	})
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&destroyed))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&inFlight))
+
+	err := l.enter(closedErr)
+	require.Error(t, err)
+	assert.Equal(t, closedErr, err)
+}
+
+// TestLifecycleShutdownOnlyDestroysOnce ensures a second shutdown call (the
+// Close-called-twice case every ModelInstance/Tensor/TensorMap Close
+// documents as safe) is a no-op.
+func TestLifecycleShutdownOnlyDestroysOnce(t *testing.T) {
+	var l lifecycle
+	var destroyCalls int32
+	destroy := func() { atomic.AddInt32(&destroyCalls, 1) }
+
+	l.shutdown(destroy)
+	l.shutdown(destroy)
+	l.shutdown(destroy)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&destroyCalls))
+}
+
+// TestLifecycleEnterAfterShutdownRejected verifies enter starts returning
+// closedErr as soon as shutdown has run, even with no in-flight accessors.
+func TestLifecycleEnterAfterShutdownRejected(t *testing.T) {
+	var l lifecycle
+	l.shutdown(func() {})
+
+	err := l.enter(assert.AnError)
+	require.Error(t, err)
+	assert.Equal(t, assert.AnError, err)
+}
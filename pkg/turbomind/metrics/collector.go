@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+// engineCollector mirrors engine.Metrics() into Prometheus counters on
+// every scrape, so the HTTP-exposed counters can never drift from the
+// atomic ones Engine already keeps for Generate/GenerateStream.
+//
+// batchFill, kvCacheEntries{Used,Max}, prefixCacheHits, and
+// sessionLenUtilization are deliberately not exposed here:
+// turbomind.Engine has no API to report batch or cache occupancy yet
+// (turbomind_get_model_info doesn't return it), and a gauge permanently
+// stuck at zero is indistinguishable from real zero occupancy to anyone
+// scraping it. Add them back once the engine binding grows a real
+// source for them.
+type engineCollector struct {
+	engine *turbomind.Engine
+
+	requestsTotal           *prometheus.Desc
+	errorsTotal             *prometheus.Desc
+	promptTokensTotal       *prometheus.Desc
+	completionTokensTotal   *prometheus.Desc
+	cachedPromptTokensTotal *prometheus.Desc
+}
+
+func newEngineCollector(engine *turbomind.Engine) *engineCollector {
+	return &engineCollector{
+		engine:                  engine,
+		requestsTotal:           prometheus.NewDesc(namespace+"_requests_total", "Total requests completed by the engine.", nil, nil),
+		errorsTotal:             prometheus.NewDesc(namespace+"_errors_total", "Total requests that finished with a non-zero error code.", nil, nil),
+		promptTokensTotal:       prometheus.NewDesc(namespace+"_prompt_tokens_total", "Total prompt tokens consumed.", nil, nil),
+		completionTokensTotal:   prometheus.NewDesc(namespace+"_completion_tokens_total", "Total completion tokens generated.", nil, nil),
+		cachedPromptTokensTotal: prometheus.NewDesc(namespace+"_cached_prompt_tokens_total", "Total prompt tokens served from the KV-cache prefix.", nil, nil),
+	}
+}
+
+func (c *engineCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requestsTotal
+	ch <- c.errorsTotal
+	ch <- c.promptTokensTotal
+	ch <- c.completionTokensTotal
+	ch <- c.cachedPromptTokensTotal
+}
+
+func (c *engineCollector) Collect(ch chan<- prometheus.Metric) {
+	snap := c.engine.Metrics()
+
+	ch <- prometheus.MustNewConstMetric(c.requestsTotal, prometheus.CounterValue, float64(snap.RequestsTotal))
+	ch <- prometheus.MustNewConstMetric(c.errorsTotal, prometheus.CounterValue, float64(snap.ErrorsTotal))
+	ch <- prometheus.MustNewConstMetric(c.promptTokensTotal, prometheus.CounterValue, float64(snap.PromptTokensTotal))
+	ch <- prometheus.MustNewConstMetric(c.completionTokensTotal, prometheus.CounterValue, float64(snap.CompletionTokensTotal))
+	ch <- prometheus.MustNewConstMetric(c.cachedPromptTokensTotal, prometheus.CounterValue, float64(snap.CachedPromptTokensTotal))
+}
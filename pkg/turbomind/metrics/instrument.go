@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+// InstrumentedEngine wraps a *turbomind.Engine, observing the histograms in
+// m around every Generate/GenerateStream call. All other Engine methods
+// are unaffected; call them on the wrapped Engine directly.
+type InstrumentedEngine struct {
+	*turbomind.Engine
+	metrics *Metrics
+}
+
+// InstrumentEngine returns an InstrumentedEngine that records m's
+// generation histograms for every call made through it.
+func InstrumentEngine(engine *turbomind.Engine, m *Metrics) *InstrumentedEngine {
+	return &InstrumentedEngine{Engine: engine, metrics: m}
+}
+
+// Generate behaves like turbomind.Engine.Generate, additionally observing
+// generation duration, time-to-first-token, and tokens/sec on success.
+func (e *InstrumentedEngine) Generate(params turbomind.RequestParams) (*turbomind.ResponseData, error) {
+	start := time.Now()
+	resp, err := e.Engine.Generate(params)
+	if err != nil || resp.ErrorCode != 0 {
+		return resp, err
+	}
+	e.metrics.generationDuration.Observe(time.Since(start).Seconds())
+	e.observeUsage(resp.Usage)
+	return resp, nil
+}
+
+// GenerateStream behaves like turbomind.Engine.GenerateStream, observing
+// the same histograms as Generate once the stream's final delta arrives.
+func (e *InstrumentedEngine) GenerateStream(ctx context.Context, params turbomind.RequestParams) (<-chan turbomind.TokenDelta, error) {
+	start := time.Now()
+	in, err := e.Engine.GenerateStream(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan turbomind.TokenDelta)
+	go func() {
+		defer close(out)
+		for delta := range in {
+			if delta.Usage != nil {
+				e.metrics.generationDuration.Observe(time.Since(start).Seconds())
+				e.observeUsage(*delta.Usage)
+			}
+			out <- delta
+		}
+	}()
+	return out, nil
+}
+
+func (e *InstrumentedEngine) observeUsage(usage turbomind.Usage) {
+	e.metrics.firstTokenLatency.Observe(usage.FirstTokenLatencyMs / 1000)
+	if usage.TokensPerSecond > 0 {
+		e.metrics.tokensPerSecond.Observe(usage.TokensPerSecond)
+	}
+}
+
+// InstrumentedTokenizer wraps a *turbomind.Tokenizer, observing m's
+// encode/decode duration histograms around every call.
+type InstrumentedTokenizer struct {
+	*turbomind.Tokenizer
+	metrics *Metrics
+}
+
+// InstrumentTokenizer returns an InstrumentedTokenizer that records m's
+// tokenizer histograms for every call made through it.
+func InstrumentTokenizer(tokenizer *turbomind.Tokenizer, m *Metrics) *InstrumentedTokenizer {
+	return &InstrumentedTokenizer{Tokenizer: tokenizer, metrics: m}
+}
+
+// Encode behaves like turbomind.Tokenizer.Encode, observing encode duration.
+func (t *InstrumentedTokenizer) Encode(text string, addSpecialTokens bool) ([]int, error) {
+	start := time.Now()
+	tokens, err := t.Tokenizer.Encode(text, addSpecialTokens)
+	t.metrics.encodeDuration.Observe(time.Since(start).Seconds())
+	return tokens, err
+}
+
+// Decode behaves like turbomind.Tokenizer.Decode, observing decode duration.
+func (t *InstrumentedTokenizer) Decode(tokens []int, skipSpecialTokens bool) (string, error) {
+	start := time.Now()
+	text, err := t.Tokenizer.Decode(tokens, skipSpecialTokens)
+	t.metrics.decodeDuration.Observe(time.Since(start).Seconds())
+	return text, err
+}
@@ -0,0 +1,80 @@
+// Package metrics exposes Prometheus text-format metrics for a running
+// turbomind.Engine: request/error/token counters and generation latency,
+// throughput and tokenizer histograms. It exposes no engine-internal gauges
+// (batch fill, KV-cache occupancy, prefix-cache hits, session length use).
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+const namespace = "turbomind"
+
+// Metrics holds the histograms this package observes directly. Request,
+// error, and token counters are not kept here: they are read straight off
+// Engine.Metrics() by an internal collector registered alongside these, so
+// the two sources of truth can never drift apart.
+type Metrics struct {
+	generationDuration prometheus.Histogram
+	firstTokenLatency  prometheus.Histogram
+	tokensPerSecond    prometheus.Histogram
+	encodeDuration     prometheus.Histogram
+	decodeDuration     prometheus.Histogram
+}
+
+// RegisterMetrics creates the collectors for engine and registers them with
+// reg. The returned *Metrics is passed to InstrumentEngine/InstrumentTokenizer
+// so their Generate/GenerateStream/Encode/Decode wrappers can observe
+// per-call histograms.
+func RegisterMetrics(reg *prometheus.Registry, engine *turbomind.Engine) (*Metrics, error) {
+	m := &Metrics{
+		generationDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "generation_duration_seconds",
+			Help:      "Wall-clock time to complete one Generate/GenerateStream request.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		firstTokenLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "first_token_latency_seconds",
+			Help:      "Time from request start to the first non-empty token delta.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		tokensPerSecond: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "tokens_per_second",
+			Help:      "Completion tokens per second of wall-clock generation time, per request.",
+			Buckets:   []float64{1, 5, 10, 25, 50, 100, 200, 400},
+		}),
+		encodeDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "tokenizer_encode_duration_seconds",
+			Help:      "Wall-clock time spent in Tokenizer.Encode.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		decodeDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "tokenizer_decode_duration_seconds",
+			Help:      "Wall-clock time spent in Tokenizer.Decode.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+	}
+
+	collectors := []prometheus.Collector{
+		m.generationDuration,
+		m.firstTokenLatency,
+		m.tokensPerSecond,
+		m.encodeDuration,
+		m.decodeDuration,
+		newEngineCollector(engine),
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
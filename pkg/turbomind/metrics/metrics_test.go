@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterMetricsRegistersEveryCollectorOnce(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	m, err := RegisterMetrics(reg, nil)
+	require.NoError(t, err)
+	require.NotNil(t, m)
+
+	// Registering the same metrics a second time against the same registry
+	// must fail with an AlreadyRegisteredError, the way Prometheus collectors
+	// always do - this is how we know RegisterMetrics actually registered
+	// something rather than silently no-opping.
+	_, err = RegisterMetrics(reg, nil)
+	assert.Error(t, err)
+}
+
+func TestHandlerServesPrometheusTextFormat(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "probe_total", Help: "probe"})
+	counter.Inc()
+	require.NoError(t, reg.Register(counter))
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	rec := httptest.NewRecorder()
+	Handler(reg).ServeHTTP(rec, req)
+
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "probe_total 1")
+}
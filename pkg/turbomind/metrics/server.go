@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Handler returns an http.Handler serving reg in Prometheus text format,
+// for embedding in a caller's own mux.
+func Handler(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+}
+
+// ServeMetrics starts an HTTP server on addr exposing reg at /metrics. It
+// blocks until the server stops, so callers typically run it in a
+// goroutine; the error it returns is always non-nil, matching
+// http.ListenAndServe.
+func ServeMetrics(addr string, reg *prometheus.Registry) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler(reg))
+	return http.ListenAndServe(addr, mux)
+}
@@ -0,0 +1,389 @@
+// Package openai exposes a running turbomind.Engine through the OpenAI REST
+// dialect (chat/completions, completions, models; the embeddings route
+// always answers 501 Not Implemented), so tools
+// built against the OpenAI SDK (LangChain, LlamaIndex, chat UIs, ...) can
+// point at TurboMind without a separate wrapper process.
+package openai
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+// Server wraps a *turbomind.Engine and Tokenizer with an http.Handler that
+// speaks the OpenAI REST dialect.
+type Server struct {
+	engine    *turbomind.Engine
+	tokenizer *turbomind.Tokenizer
+	modelName string
+	mux       *http.ServeMux
+	nextReqID int64
+}
+
+// NewServer creates a Server backed by the given engine and tokenizer.
+// modelName is reported back in responses and GET /v1/models.
+func NewServer(engine *turbomind.Engine, tokenizer *turbomind.Tokenizer, modelName string) *Server {
+	s := &Server{
+		engine:    engine,
+		tokenizer: tokenizer,
+		modelName: modelName,
+	}
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("/v1/chat/completions", s.handleChatCompletions)
+	s.mux.HandleFunc("/v1/completions", s.handleCompletions)
+	s.mux.HandleFunc("/v1/embeddings", s.handleEmbeddings)
+	s.mux.HandleFunc("/v1/models", s.handleModels)
+
+	return s
+}
+
+// Handler returns the http.Handler serving the OpenAI routes, for embedding
+// in a caller's own mux or for passing straight to http.ListenAndServe.
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+func (s *Server) handleModels(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed", "invalid_request_error")
+		return
+	}
+	writeJSON(w, http.StatusOK, ModelList{
+		Object: "list",
+		Data: []Model{{
+			ID:      s.modelName,
+			Object:  "model",
+			Created: time.Now().Unix(),
+			OwnedBy: "turbomind",
+		}},
+	})
+}
+
+func (s *Server) handleChatCompletions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed", "invalid_request_error")
+		return
+	}
+
+	var req ChatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err), "invalid_request_error")
+		return
+	}
+	if len(req.Messages) == 0 {
+		writeError(w, http.StatusBadRequest, "messages must not be empty", "invalid_request_error")
+		return
+	}
+
+	messages := make([]turbomind.ChatMessage, len(req.Messages))
+	for i, m := range req.Messages {
+		messages[i] = turbomind.ChatMessage{Role: m.Role, Content: m.Content}
+	}
+	prompt, _, err := s.tokenizer.ApplyChatTemplate(messages, true)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to render chat template: %v", err), "server_error")
+		return
+	}
+
+	n := 1
+	if req.N != nil && *req.N > 0 {
+		n = *req.N
+	}
+
+	id := fmt.Sprintf("chatcmpl-%d", s.allocRequestID())
+	created := time.Now().Unix()
+
+	if req.Stream {
+		s.streamChat(w, r, id, created, prompt, req, n)
+		return
+	}
+
+	choices := make([]ChatCompletionChoice, 0, n)
+	var usage Usage
+	for i := 0; i < n; i++ {
+		params := mapChatParams(req, prompt, s.allocRequestID())
+		resp, err := s.engine.Generate(params)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("generation failed: %v", err), "server_error")
+			return
+		}
+		choices = append(choices, ChatCompletionChoice{
+			Index:        i,
+			Message:      ChatMessage{Role: "assistant", Content: resp.Text},
+			FinishReason: "stop",
+		})
+		usage.PromptTokens = resp.Usage.PromptTokens
+		usage.CompletionTokens += resp.Usage.CompletionTokens
+	}
+	usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+
+	writeJSON(w, http.StatusOK, ChatCompletionResponse{
+		ID:      id,
+		Object:  "chat.completion",
+		Created: created,
+		Model:   s.modelName,
+		Choices: choices,
+		Usage:   usage,
+	})
+}
+
+// streamChat runs one completion per choice through GenerateStream and
+// relays each real TokenDelta as its own SSE chunk, so stream=true actually
+// gets token-level latency instead of a full Generate dribbled out as fake
+// deltas.
+func (s *Server) streamChat(w http.ResponseWriter, r *http.Request, id string, created int64, prompt string, req ChatCompletionRequest, n int) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming unsupported by response writer", "server_error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	for i := 0; i < n; i++ {
+		params := mapChatParams(req, prompt, s.allocRequestID())
+		deltas, err := s.engine.GenerateStream(r.Context(), params)
+		if err != nil {
+			writeSSE(w, ErrorResponse{Error: ErrorDetail{Message: err.Error(), Type: "server_error"}})
+			flusher.Flush()
+			continue
+		}
+
+		for delta := range deltas {
+			if delta.FinishReason != "" {
+				var finish *string
+				if delta.FinishReason != "error" {
+					f := delta.FinishReason
+					finish = &f
+				}
+				writeSSE(w, ChatCompletionChunk{
+					ID:      id,
+					Object:  "chat.completion.chunk",
+					Created: created,
+					Model:   s.modelName,
+					Choices: []ChatCompletionChunkChoice{{Index: i, Delta: ChatMessage{}, FinishReason: finish}},
+				})
+				flusher.Flush()
+				continue
+			}
+			writeSSE(w, ChatCompletionChunk{
+				ID:      id,
+				Object:  "chat.completion.chunk",
+				Created: created,
+				Model:   s.modelName,
+				Choices: []ChatCompletionChunkChoice{{Index: i, Delta: ChatMessage{Content: delta.Text}}},
+			})
+			flusher.Flush()
+		}
+	}
+
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	flusher.Flush()
+}
+
+func (s *Server) handleCompletions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed", "invalid_request_error")
+		return
+	}
+
+	var req CompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err), "invalid_request_error")
+		return
+	}
+	if req.Prompt == "" {
+		writeError(w, http.StatusBadRequest, "prompt must not be empty", "invalid_request_error")
+		return
+	}
+
+	n := 1
+	if req.N != nil && *req.N > 0 {
+		n = *req.N
+	}
+
+	id := fmt.Sprintf("cmpl-%d", s.allocRequestID())
+	created := time.Now().Unix()
+
+	if req.Stream {
+		s.streamCompletion(w, r, id, created, req, n)
+		return
+	}
+
+	choices := make([]CompletionChoice, 0, n)
+	var usage Usage
+	for i := 0; i < n; i++ {
+		params := mapCompletionParams(req, s.allocRequestID())
+		resp, err := s.engine.Generate(params)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("generation failed: %v", err), "server_error")
+			return
+		}
+		choices = append(choices, CompletionChoice{Index: i, Text: resp.Text, FinishReason: "stop"})
+		usage.PromptTokens = resp.Usage.PromptTokens
+		usage.CompletionTokens += resp.Usage.CompletionTokens
+	}
+	usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+
+	writeJSON(w, http.StatusOK, CompletionResponse{
+		ID:      id,
+		Object:  "text_completion",
+		Created: created,
+		Model:   s.modelName,
+		Choices: choices,
+		Usage:   usage,
+	})
+}
+
+// streamCompletion runs one completion per choice through GenerateStream
+// and relays each real TokenDelta as its own SSE chunk.
+func (s *Server) streamCompletion(w http.ResponseWriter, r *http.Request, id string, created int64, req CompletionRequest, n int) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming unsupported by response writer", "server_error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	for i := 0; i < n; i++ {
+		params := mapCompletionParams(req, s.allocRequestID())
+		deltas, err := s.engine.GenerateStream(r.Context(), params)
+		if err != nil {
+			writeSSE(w, ErrorResponse{Error: ErrorDetail{Message: err.Error(), Type: "server_error"}})
+			flusher.Flush()
+			continue
+		}
+
+		for delta := range deltas {
+			if delta.FinishReason != "" {
+				finish := delta.FinishReason
+				if finish == "error" {
+					finish = "stop"
+				}
+				writeSSE(w, CompletionChunk{
+					ID:      id,
+					Object:  "text_completion",
+					Created: created,
+					Model:   s.modelName,
+					Choices: []CompletionChoice{{Index: i, FinishReason: finish}},
+				})
+				flusher.Flush()
+				continue
+			}
+			writeSSE(w, CompletionChunk{
+				ID:      id,
+				Object:  "text_completion",
+				Created: created,
+				Model:   s.modelName,
+				Choices: []CompletionChoice{{Index: i, Text: delta.Text}},
+			})
+			flusher.Flush()
+		}
+	}
+
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	flusher.Flush()
+}
+
+// handleEmbeddings reports a clear "not supported" error: TurboMind's
+// decoder-only engine has no pooled hidden-state output wired up yet.
+func (s *Server) handleEmbeddings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed", "invalid_request_error")
+		return
+	}
+
+	var req EmbeddingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err), "invalid_request_error")
+		return
+	}
+
+	writeError(w, http.StatusNotImplemented, "embeddings are not supported by this engine", "unsupported_operation")
+}
+
+func (s *Server) allocRequestID() int64 {
+	return atomic.AddInt64(&s.nextReqID, 1)
+}
+
+func mapChatParams(req ChatCompletionRequest, prompt string, requestID int64) turbomind.RequestParams {
+	params := turbomind.RequestParams{
+		RequestID: requestID,
+		Prompt:    prompt,
+		Stream:    false, // ignored by Generate/GenerateStream; which one runs is picked by the caller
+	}
+	applyCommonParams(&params, req.Temperature, req.TopP, req.TopK, req.MaxTokens, req.Stop, req.PresencePenalty, req.FrequencyPenalty)
+	return params
+}
+
+func mapCompletionParams(req CompletionRequest, requestID int64) turbomind.RequestParams {
+	params := turbomind.RequestParams{
+		RequestID: requestID,
+		Prompt:    req.Prompt,
+		Stream:    false,
+	}
+	applyCommonParams(&params, req.Temperature, req.TopP, req.TopK, req.MaxTokens, req.Stop, req.PresencePenalty, req.FrequencyPenalty)
+	return params
+}
+
+func applyCommonParams(params *turbomind.RequestParams, temperature, topP, topK *float32, maxTokens *int, stop *StopWords, presencePenalty, frequencyPenalty *float32) {
+	if temperature != nil {
+		params.Temperature = *temperature
+	}
+	if topP != nil {
+		params.TopP = *topP
+	}
+	if topK != nil {
+		params.TopK = *topK
+	}
+	if maxTokens != nil {
+		params.MaxNewTokens = *maxTokens
+	}
+	if presencePenalty != nil {
+		params.PresencePenalty = *presencePenalty
+	}
+	if frequencyPenalty != nil {
+		params.FrequencyPenalty = *frequencyPenalty
+	}
+	if stop != nil {
+		if data, err := json.Marshal([]string(*stop)); err == nil {
+			params.StopWords = string(data)
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeSSE(w http.ResponseWriter, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "data: %s\n\n", data)
+}
+
+func writeError(w http.ResponseWriter, status int, message, errType string) {
+	writeJSON(w, status, ErrorResponse{Error: ErrorDetail{
+		Message: message,
+		Type:    errType,
+		Code:    strconv.Itoa(status),
+	}})
+}
@@ -0,0 +1,48 @@
+package openai
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopWordsUnmarshalSingle(t *testing.T) {
+	var s StopWords
+	require.NoError(t, json.Unmarshal([]byte(`"</s>"`), &s))
+	assert.Equal(t, StopWords{"</s>"}, s)
+}
+
+func TestStopWordsUnmarshalList(t *testing.T) {
+	var s StopWords
+	require.NoError(t, json.Unmarshal([]byte(`["</s>", "\n\n"]`), &s))
+	assert.Equal(t, StopWords{"</s>", "\n\n"}, s)
+}
+
+func TestMapChatParams(t *testing.T) {
+	temp := float32(0.5)
+	maxTokens := 42
+	stop := StopWords{"</s>"}
+
+	req := ChatCompletionRequest{
+		Temperature: &temp,
+		MaxTokens:   &maxTokens,
+		Stop:        &stop,
+	}
+
+	params := mapChatParams(req, "rendered prompt", 7)
+	assert.Equal(t, int64(7), params.RequestID)
+	assert.Equal(t, "rendered prompt", params.Prompt)
+	assert.Equal(t, float32(0.5), params.Temperature)
+	assert.Equal(t, 42, params.MaxNewTokens)
+	assert.JSONEq(t, `["</s>"]`, params.StopWords)
+	assert.False(t, params.Stream)
+}
+
+func TestMapCompletionParamsDefaults(t *testing.T) {
+	req := CompletionRequest{Prompt: "hello"}
+	params := mapCompletionParams(req, 1)
+	assert.Equal(t, "hello", params.Prompt)
+	assert.Equal(t, float32(0), params.Temperature)
+}
@@ -0,0 +1,171 @@
+package openai
+
+import "encoding/json"
+
+// ChatMessage is a single OpenAI chat message.
+type ChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// ChatCompletionRequest mirrors the OpenAI POST /v1/chat/completions body.
+type ChatCompletionRequest struct {
+	Model            string        `json:"model"`
+	Messages         []ChatMessage `json:"messages"`
+	Temperature      *float32      `json:"temperature,omitempty"`
+	TopP             *float32      `json:"top_p,omitempty"`
+	TopK             *float32      `json:"top_k,omitempty"`
+	MaxTokens        *int          `json:"max_tokens,omitempty"`
+	Stop             *StopWords    `json:"stop,omitempty"`
+	PresencePenalty  *float32      `json:"presence_penalty,omitempty"`
+	FrequencyPenalty *float32      `json:"frequency_penalty,omitempty"`
+	N                *int          `json:"n,omitempty"`
+	Stream           bool          `json:"stream,omitempty"`
+}
+
+// ChatCompletionChoice is one element of ChatCompletionResponse.Choices.
+type ChatCompletionChoice struct {
+	Index        int         `json:"index"`
+	Message      ChatMessage `json:"message"`
+	FinishReason string      `json:"finish_reason"`
+}
+
+// ChatCompletionResponse mirrors the non-streaming chat completion reply.
+type ChatCompletionResponse struct {
+	ID      string                 `json:"id"`
+	Object  string                 `json:"object"`
+	Created int64                  `json:"created"`
+	Model   string                 `json:"model"`
+	Choices []ChatCompletionChoice `json:"choices"`
+	Usage   Usage                  `json:"usage"`
+}
+
+// ChatCompletionChunkChoice is one element of a streamed chat completion chunk.
+type ChatCompletionChunkChoice struct {
+	Index        int         `json:"index"`
+	Delta        ChatMessage `json:"delta"`
+	FinishReason *string     `json:"finish_reason"`
+}
+
+// ChatCompletionChunk is a single `data: {...}` SSE event for a streamed chat completion.
+type ChatCompletionChunk struct {
+	ID      string                      `json:"id"`
+	Object  string                      `json:"object"`
+	Created int64                       `json:"created"`
+	Model   string                      `json:"model"`
+	Choices []ChatCompletionChunkChoice `json:"choices"`
+}
+
+// CompletionRequest mirrors the OpenAI POST /v1/completions body.
+type CompletionRequest struct {
+	Model            string     `json:"model"`
+	Prompt           string     `json:"prompt"`
+	Temperature      *float32   `json:"temperature,omitempty"`
+	TopP             *float32   `json:"top_p,omitempty"`
+	TopK             *float32   `json:"top_k,omitempty"`
+	MaxTokens        *int       `json:"max_tokens,omitempty"`
+	Stop             *StopWords `json:"stop,omitempty"`
+	PresencePenalty  *float32   `json:"presence_penalty,omitempty"`
+	FrequencyPenalty *float32   `json:"frequency_penalty,omitempty"`
+	N                *int       `json:"n,omitempty"`
+	Stream           bool       `json:"stream,omitempty"`
+}
+
+// CompletionChoice is one element of CompletionResponse.Choices.
+type CompletionChoice struct {
+	Index        int    `json:"index"`
+	Text         string `json:"text"`
+	FinishReason string `json:"finish_reason"`
+}
+
+// CompletionResponse mirrors the non-streaming text completion reply.
+type CompletionResponse struct {
+	ID      string             `json:"id"`
+	Object  string             `json:"object"`
+	Created int64              `json:"created"`
+	Model   string             `json:"model"`
+	Choices []CompletionChoice `json:"choices"`
+	Usage   Usage              `json:"usage"`
+}
+
+// CompletionChunk is a single `data: {...}` SSE event for a streamed text completion.
+type CompletionChunk struct {
+	ID      string             `json:"id"`
+	Object  string             `json:"object"`
+	Created int64              `json:"created"`
+	Model   string             `json:"model"`
+	Choices []CompletionChoice `json:"choices"`
+}
+
+// EmbeddingsRequest mirrors the OpenAI POST /v1/embeddings body.
+type EmbeddingsRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+// Embedding is one element of EmbeddingsResponse.Data.
+type Embedding struct {
+	Object    string    `json:"object"`
+	Index     int       `json:"index"`
+	Embedding []float32 `json:"embedding"`
+}
+
+// EmbeddingsResponse mirrors the OpenAI embeddings reply.
+type EmbeddingsResponse struct {
+	Object string      `json:"object"`
+	Data   []Embedding `json:"data"`
+	Model  string      `json:"model"`
+	Usage  Usage       `json:"usage"`
+}
+
+// Model describes one entry of GET /v1/models.
+type Model struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+}
+
+// ModelList is the GET /v1/models reply.
+type ModelList struct {
+	Object string  `json:"object"`
+	Data   []Model `json:"data"`
+}
+
+// Usage reports token accounting in the shape the OpenAI SDK expects.
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// ErrorResponse mirrors the OpenAI `{"error": {...}}` error envelope.
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail is the body of ErrorResponse.
+type ErrorDetail struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+	Code    string `json:"code,omitempty"`
+}
+
+// StopWords accepts either a single stop string or a list of them, matching
+// the two shapes OpenAI clients send for the "stop" field.
+type StopWords []string
+
+// UnmarshalJSON implements json.Unmarshaler for StopWords.
+func (s *StopWords) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = StopWords{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
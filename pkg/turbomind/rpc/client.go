@@ -0,0 +1,277 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+// Client mirrors the Engine/Tokenizer API over a single JSON-RPC
+// connection. A Client serializes calls one at a time (mu), matching the
+// server's one-request-in-flight-per-call model; open multiple Clients to
+// issue requests concurrently.
+type Client struct {
+	conn   net.Conn
+	enc    *json.Encoder
+	dec    *json.Decoder
+	mu     sync.Mutex
+	nextID int64
+}
+
+// DialTCP connects to a Server's TCP listener.
+func DialTCP(addr string) (*Client, error) {
+	return dial("tcp", addr)
+}
+
+// DialUnix connects to a Server's Unix-socket listener.
+func DialUnix(path string) (*Client, error) {
+	return dial("unix", path)
+}
+
+func dial(network, addr string) (*Client, error) {
+	conn, err := net.Dial(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, enc: json.NewEncoder(conn), dec: json.NewDecoder(conn)}, nil
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// call issues a non-streaming request and decodes its result into out.
+func (c *Client) call(method string, params interface{}, out interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resp, err := c.roundTrip(method, params)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if out != nil && len(resp.Result) > 0 {
+		return json.Unmarshal(resp.Result, out)
+	}
+	return nil
+}
+
+func (c *Client) roundTrip(method string, params interface{}) (*Response, error) {
+	id := atomic.AddInt64(&c.nextID, 1)
+	idRaw, err := json.Marshal(id)
+	if err != nil {
+		return nil, err
+	}
+	paramsRaw, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.enc.Encode(Request{JSONRPC: jsonrpcVersion, ID: idRaw, Method: method, Params: paramsRaw}); err != nil {
+		return nil, err
+	}
+
+	var resp Response
+	if err := c.dec.Decode(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Generate runs a non-streaming generation request.
+func (c *Client) Generate(params turbomind.RequestParams) (*turbomind.ResponseData, error) {
+	wire := toWireParams(params)
+	wire.Stream = false
+	var resp turbomind.ResponseData
+	if err := c.call("Engine.Generate", wire, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StreamGenerate runs a streaming generation request, invoking onToken for
+// every "turbomind.token" notification and onFinished for the
+// "turbomind.finished" notification, then returns the final response.
+// Either callback may be nil.
+func (c *Client) StreamGenerate(params turbomind.RequestParams, onToken func(TokenNotification), onFinished func(FinishedNotification)) (*turbomind.ResponseData, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wire := toWireParams(params)
+	wire.Stream = true
+	id := atomic.AddInt64(&c.nextID, 1)
+	idRaw, err := json.Marshal(id)
+	if err != nil {
+		return nil, err
+	}
+	paramsRaw, err := json.Marshal(wire)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.enc.Encode(Request{JSONRPC: jsonrpcVersion, ID: idRaw, Method: "Engine.Generate", Params: paramsRaw}); err != nil {
+		return nil, err
+	}
+
+	for {
+		var probe struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		raw := json.RawMessage{}
+		if err := c.dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &probe); err != nil {
+			return nil, err
+		}
+
+		if probe.Method != "" {
+			var note Notification
+			if err := json.Unmarshal(raw, &note); err != nil {
+				return nil, err
+			}
+			switch note.Method {
+			case notifyToken:
+				if onToken != nil {
+					var tok TokenNotification
+					if err := json.Unmarshal(note.Params, &tok); err == nil {
+						onToken(tok)
+					}
+				}
+			case notifyFinished:
+				if onFinished != nil {
+					var fin FinishedNotification
+					if err := json.Unmarshal(note.Params, &fin); err == nil {
+						onFinished(fin)
+					}
+				}
+			}
+			continue
+		}
+
+		var resp Response
+		if err := json.Unmarshal(raw, &resp); err != nil {
+			return nil, err
+		}
+		if resp.Error != nil {
+			return nil, resp.Error
+		}
+		var result turbomind.ResponseData
+		if len(resp.Result) > 0 {
+			if err := json.Unmarshal(resp.Result, &result); err != nil {
+				return nil, err
+			}
+		}
+		return &result, nil
+	}
+}
+
+// GenerateAsync dispatches generation in the background and returns its
+// request id; poll GetResponse for progress.
+func (c *Client) GenerateAsync(params turbomind.RequestParams) (int64, error) {
+	wire := toWireParams(params)
+	var result struct {
+		RequestID int64 `json:"request_id"`
+	}
+	if err := c.call("Engine.GenerateAsync", wire, &result); err != nil {
+		return 0, err
+	}
+	return result.RequestID, nil
+}
+
+// GetResponse polls the current state of a request started with
+// GenerateAsync.
+func (c *Client) GetResponse(requestID int64) (*turbomind.ResponseData, error) {
+	var resp turbomind.ResponseData
+	params := struct {
+		RequestID int64 `json:"request_id"`
+	}{requestID}
+	if err := c.call("Engine.GetResponse", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetModelInfo fetches the remote engine's model info.
+func (c *Client) GetModelInfo() (*turbomind.ModelInfo, error) {
+	var info turbomind.ModelInfo
+	if err := c.call("Engine.GetModelInfo", struct{}{}, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// Encode tokenizes text via the remote tokenizer.
+func (c *Client) Encode(text string, addSpecialTokens bool) ([]int, error) {
+	params := struct {
+		Text             string `json:"text"`
+		AddSpecialTokens bool   `json:"add_special_tokens"`
+	}{text, addSpecialTokens}
+	var tokens []int
+	if err := c.call("Tokenizer.Encode", params, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Decode detokenizes tokens via the remote tokenizer.
+func (c *Client) Decode(tokens []int, skipSpecialTokens bool) (string, error) {
+	params := struct {
+		Tokens            []int `json:"tokens"`
+		SkipSpecialTokens bool  `json:"skip_special_tokens"`
+	}{tokens, skipSpecialTokens}
+	var text string
+	if err := c.call("Tokenizer.Decode", params, &text); err != nil {
+		return "", err
+	}
+	return text, nil
+}
+
+// EncodeWithOffset tokenizes text and returns each token's byte offsets
+// via the remote tokenizer.
+func (c *Client) EncodeWithOffset(text string, addSpecialTokens bool) ([]int, [][2]int, error) {
+	params := struct {
+		Text             string `json:"text"`
+		AddSpecialTokens bool   `json:"add_special_tokens"`
+	}{text, addSpecialTokens}
+	var result struct {
+		Tokens  []int    `json:"tokens"`
+		Offsets [][2]int `json:"offsets"`
+	}
+	if err := c.call("Tokenizer.EncodeWithOffset", params, &result); err != nil {
+		return nil, nil, err
+	}
+	return result.Tokens, result.Offsets, nil
+}
+
+// GetVersion fetches the remote binary's version info.
+func (c *Client) GetVersion() (turbomind.VersionInfo, error) {
+	var info turbomind.VersionInfo
+	err := c.call("GetVersion", struct{}{}, &info)
+	return info, err
+}
+
+func toWireParams(params turbomind.RequestParams) generateParams {
+	var stopWords []string
+	if params.StopWords != "" {
+		_ = json.Unmarshal([]byte(params.StopWords), &stopWords)
+	}
+	return generateParams{
+		RequestID:         params.RequestID,
+		Prompt:            params.Prompt,
+		MaxNewTokens:      params.MaxNewTokens,
+		Temperature:       params.Temperature,
+		TopP:              params.TopP,
+		TopK:              params.TopK,
+		RepetitionPenalty: params.RepetitionPenalty,
+		PresencePenalty:   params.PresencePenalty,
+		FrequencyPenalty:  params.FrequencyPenalty,
+		Stream:            params.Stream,
+		StopWords:         stopWords,
+	}
+}
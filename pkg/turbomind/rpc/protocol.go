@@ -0,0 +1,126 @@
+// Package rpc exposes a turbomind.Engine and Tokenizer to remote clients
+// that can't link cgo, over a hand-rolled JSON-RPC 2.0 framing on top of
+// plain TCP or Unix-socket connections.
+//
+// net/rpc's codec interfaces assume one response per call and have no way
+// for a server to push unsolicited messages on the same connection, so
+// they can't carry the streaming token notifications this package needs;
+// instead each accepted connection is driven by a small read/dispatch loop
+// here, in the spirit of (but not literally) the cenkalti/rpc2 pattern:
+// ordinary JSON-RPC 2.0 requests/responses, plus id-less notifications the
+// server can send at any time.
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+const jsonrpcVersion = "2.0"
+
+// Notification methods pushed by the server while a streaming
+// Engine.Generate call is in flight.
+const (
+	notifyToken    = "turbomind.token"
+	notifyFinished = "turbomind.finished"
+)
+
+// Request is a JSON-RPC 2.0 request object.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC 2.0 response object.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// Notification is a JSON-RPC 2.0 notification: a request with no id, sent
+// server -> client with no matching response expected.
+type Notification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// RPCError is the JSON-RPC 2.0 error object.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string { return e.Message }
+
+// Standard JSON-RPC 2.0 error codes.
+const (
+	errCodeParse          = -32700
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+	errCodeInternal       = -32603
+)
+
+// generateParams is the wire shape of Engine.Generate / Engine.GenerateAsync
+// params, mirroring turbomind.RequestParams with JSON tags (RequestParams
+// itself has none, matching the rest of this file).
+type generateParams struct {
+	RequestID         int64    `json:"request_id"`
+	Prompt            string   `json:"prompt"`
+	MaxNewTokens      int      `json:"max_new_tokens"`
+	Temperature       float32  `json:"temperature"`
+	TopP              float32  `json:"top_p"`
+	TopK              float32  `json:"top_k"`
+	RepetitionPenalty int      `json:"repetition_penalty"`
+	PresencePenalty   float32  `json:"presence_penalty"`
+	FrequencyPenalty  float32  `json:"frequency_penalty"`
+	Stream            bool     `json:"stream"`
+	StopWords         []string `json:"stop_words"`
+}
+
+func (p generateParams) toRequestParams() (turbomind.RequestParams, error) {
+	stopWords := "[]"
+	if len(p.StopWords) > 0 {
+		encoded, err := json.Marshal(p.StopWords)
+		if err != nil {
+			return turbomind.RequestParams{}, err
+		}
+		stopWords = string(encoded)
+	}
+
+	return turbomind.RequestParams{
+		RequestID:         p.RequestID,
+		Prompt:            p.Prompt,
+		MaxNewTokens:      p.MaxNewTokens,
+		Temperature:       p.Temperature,
+		TopP:              p.TopP,
+		TopK:              p.TopK,
+		RepetitionPenalty: p.RepetitionPenalty,
+		PresencePenalty:   p.PresencePenalty,
+		FrequencyPenalty:  p.FrequencyPenalty,
+		Stream:            p.Stream,
+		StopWords:         stopWords,
+	}, nil
+}
+
+// TokenNotification is the Params payload of a "turbomind.token" notification.
+type TokenNotification struct {
+	RequestID int64  `json:"request_id"`
+	Text      string `json:"text"`
+	TokenID   int    `json:"token_id"`
+	Index     int    `json:"index"`
+}
+
+// FinishedNotification is the Params payload of a "turbomind.finished" notification.
+type FinishedNotification struct {
+	RequestID    int64            `json:"request_id"`
+	FinishReason string           `json:"finish_reason"`
+	ErrorMessage string           `json:"error_message,omitempty"`
+	Usage        *turbomind.Usage `json:"usage,omitempty"`
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateParamsToRequestParamsEncodesStopWords(t *testing.T) {
+	p := generateParams{
+		RequestID:    1,
+		Prompt:       "hello",
+		MaxNewTokens: 32,
+		StopWords:    []string{"</s>", "\n\n"},
+	}
+
+	params, err := p.toRequestParams()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), params.RequestID)
+	assert.Equal(t, "hello", params.Prompt)
+	assert.Equal(t, 32, params.MaxNewTokens)
+	assert.JSONEq(t, `["</s>", "\n\n"]`, params.StopWords)
+}
+
+func TestGenerateParamsToRequestParamsDefaultsEmptyStopWords(t *testing.T) {
+	p := generateParams{RequestID: 2, Prompt: "hi"}
+
+	params, err := p.toRequestParams()
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, params.StopWords)
+}
+
+func TestRequestRoundTripsThroughJSON(t *testing.T) {
+	raw := `{"jsonrpc":"2.0","id":1,"method":"turbomind.generate","params":{"prompt":"hi"}}`
+
+	var req Request
+	require.NoError(t, json.Unmarshal([]byte(raw), &req))
+	assert.Equal(t, jsonrpcVersion, req.JSONRPC)
+	assert.Equal(t, "turbomind.generate", req.Method)
+	assert.JSONEq(t, `1`, string(req.ID))
+	assert.JSONEq(t, `{"prompt":"hi"}`, string(req.Params))
+}
+
+func TestRPCErrorSatisfiesError(t *testing.T) {
+	var err error = &RPCError{Code: errCodeMethodNotFound, Message: "method not found"}
+	assert.Equal(t, "method not found", err.Error())
+}
+
+func TestResponseOmitsAbsentFields(t *testing.T) {
+	resp := Response{JSONRPC: jsonrpcVersion, ID: json.RawMessage(`1`), Result: json.RawMessage(`"ok"`)}
+
+	encoded, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"ok"}`, string(encoded))
+}
@@ -0,0 +1,348 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/xianml/turbomind-go/pkg/turbomind"
+)
+
+// Server dispatches JSON-RPC 2.0 requests against an Engine and Tokenizer.
+// Create one with NewServer, then run ListenTCP and/or ListenUnix (each
+// blocks, so run them in their own goroutines to serve both at once).
+type Server struct {
+	engine    *turbomind.Engine
+	tokenizer *turbomind.Tokenizer
+
+	mu      sync.Mutex
+	pending map[int64]*asyncRequest
+}
+
+// asyncRequest tracks a request dispatched via Engine.GenerateAsync until
+// Engine.GetResponse reports it Finished and the caller has fetched that
+// final state once.
+type asyncRequest struct {
+	mu   sync.Mutex
+	resp turbomind.ResponseData
+}
+
+// NewServer wraps engine and tokenizer for RPC dispatch.
+func NewServer(engine *turbomind.Engine, tokenizer *turbomind.Tokenizer) *Server {
+	return &Server{engine: engine, tokenizer: tokenizer, pending: make(map[int64]*asyncRequest)}
+}
+
+// ListenTCP accepts JSON-RPC connections on addr until the listener errors
+// or is closed.
+func (s *Server) ListenTCP(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.serve(ln)
+}
+
+// ListenUnix accepts JSON-RPC connections on the Unix socket at path until
+// the listener errors or is closed.
+func (s *Server) ListenUnix(path string) error {
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	return s.serve(ln)
+}
+
+func (s *Server) serve(ln net.Listener) error {
+	defer ln.Close()
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	cw := &connWriter{enc: json.NewEncoder(conn)}
+	dec := json.NewDecoder(conn)
+	for {
+		var req Request
+		if err := dec.Decode(&req); err != nil {
+			return
+		}
+		s.dispatch(cw, req)
+	}
+}
+
+func (s *Server) dispatch(cw *connWriter, req Request) {
+	switch req.Method {
+	case "Engine.Generate":
+		s.handleGenerate(cw, req.ID, req.Params)
+	case "Engine.GenerateAsync":
+		s.handleGenerateAsync(cw, req.ID, req.Params)
+	case "Engine.GetResponse":
+		s.handleGetResponse(cw, req.ID, req.Params)
+	case "Engine.GetModelInfo":
+		s.handleGetModelInfo(cw, req.ID)
+	case "Tokenizer.Encode":
+		s.handleEncode(cw, req.ID, req.Params)
+	case "Tokenizer.Decode":
+		s.handleDecode(cw, req.ID, req.Params)
+	case "Tokenizer.EncodeWithOffset":
+		s.handleEncodeWithOffset(cw, req.ID, req.Params)
+	case "GetVersion":
+		cw.writeResult(req.ID, turbomind.GetVersion())
+	default:
+		cw.writeError(req.ID, errCodeMethodNotFound, "method not found: "+req.Method)
+	}
+}
+
+func (s *Server) handleGenerate(cw *connWriter, id json.RawMessage, raw json.RawMessage) {
+	var p generateParams
+	if err := json.Unmarshal(raw, &p); err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+	rp, err := p.toRequestParams()
+	if err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+
+	if !p.Stream {
+		resp, err := s.engine.Generate(rp)
+		if err != nil {
+			cw.writeError(id, errCodeInternal, err.Error())
+			return
+		}
+		cw.writeResult(id, resp)
+		return
+	}
+
+	deltas, err := s.engine.GenerateStream(context.Background(), rp)
+	if err != nil {
+		cw.writeError(id, errCodeInternal, err.Error())
+		return
+	}
+
+	resp := &turbomind.ResponseData{RequestID: rp.RequestID}
+	var text strings.Builder
+	for delta := range deltas {
+		if delta.FinishReason != "error" {
+			text.WriteString(delta.Text)
+			cw.writeNotification(notifyToken, TokenNotification{
+				RequestID: rp.RequestID,
+				Text:      delta.Text,
+				TokenID:   delta.TokenID,
+				Index:     delta.Index,
+			})
+		}
+		if delta.FinishReason != "" {
+			fin := FinishedNotification{RequestID: rp.RequestID, FinishReason: delta.FinishReason, Usage: delta.Usage}
+			if delta.FinishReason == "error" {
+				fin.ErrorMessage = delta.Text
+				resp.ErrorCode = 1
+				resp.ErrorMessage = delta.Text
+			}
+			cw.writeNotification(notifyFinished, fin)
+			resp.Finished = true
+		}
+		if delta.Usage != nil {
+			resp.Usage = *delta.Usage
+			resp.InputTokens = delta.Usage.PromptTokens
+			resp.OutputTokens = delta.Usage.CompletionTokens
+		}
+	}
+	if resp.ErrorCode == 0 {
+		resp.Text = text.String()
+	}
+	cw.writeResult(id, resp)
+}
+
+// handleGenerateAsync starts generation in the background and returns
+// immediately with the request id; the caller polls Engine.GetResponse for
+// progress, mirroring Engine.GenerateStream's deltas without a dedicated
+// streaming connection.
+func (s *Server) handleGenerateAsync(cw *connWriter, id json.RawMessage, raw json.RawMessage) {
+	var p generateParams
+	if err := json.Unmarshal(raw, &p); err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+	rp, err := p.toRequestParams()
+	if err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+
+	deltas, err := s.engine.GenerateStream(context.Background(), rp)
+	if err != nil {
+		cw.writeError(id, errCodeInternal, err.Error())
+		return
+	}
+
+	state := &asyncRequest{resp: turbomind.ResponseData{RequestID: rp.RequestID}}
+	s.mu.Lock()
+	s.pending[rp.RequestID] = state
+	s.mu.Unlock()
+
+	go func() {
+		var text strings.Builder
+		for delta := range deltas {
+			if delta.FinishReason != "error" {
+				text.WriteString(delta.Text)
+			}
+			state.mu.Lock()
+			state.resp.Text = text.String()
+			if delta.FinishReason != "" {
+				state.resp.Finished = true
+				if delta.FinishReason == "error" {
+					state.resp.ErrorCode = 1
+					state.resp.ErrorMessage = delta.Text
+				}
+			}
+			if delta.Usage != nil {
+				state.resp.Usage = *delta.Usage
+				state.resp.InputTokens = delta.Usage.PromptTokens
+				state.resp.OutputTokens = delta.Usage.CompletionTokens
+			}
+			state.mu.Unlock()
+		}
+	}()
+
+	cw.writeResult(id, struct {
+		RequestID int64 `json:"request_id"`
+	}{rp.RequestID})
+}
+
+func (s *Server) handleGetResponse(cw *connWriter, id json.RawMessage, raw json.RawMessage) {
+	var p struct {
+		RequestID int64 `json:"request_id"`
+	}
+	if err := json.Unmarshal(raw, &p); err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	state, ok := s.pending[p.RequestID]
+	s.mu.Unlock()
+	if !ok {
+		cw.writeError(id, errCodeInvalidParams, "unknown request_id")
+		return
+	}
+
+	state.mu.Lock()
+	resp := state.resp
+	state.mu.Unlock()
+
+	if resp.Finished {
+		s.mu.Lock()
+		delete(s.pending, p.RequestID)
+		s.mu.Unlock()
+	}
+	cw.writeResult(id, resp)
+}
+
+func (s *Server) handleGetModelInfo(cw *connWriter, id json.RawMessage) {
+	info, err := s.engine.GetModelInfo()
+	if err != nil {
+		cw.writeError(id, errCodeInternal, err.Error())
+		return
+	}
+	cw.writeResult(id, info)
+}
+
+func (s *Server) handleEncode(cw *connWriter, id json.RawMessage, raw json.RawMessage) {
+	var p struct {
+		Text             string `json:"text"`
+		AddSpecialTokens bool   `json:"add_special_tokens"`
+	}
+	if err := json.Unmarshal(raw, &p); err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+	tokens, err := s.tokenizer.Encode(p.Text, p.AddSpecialTokens)
+	if err != nil {
+		cw.writeError(id, errCodeInternal, err.Error())
+		return
+	}
+	cw.writeResult(id, tokens)
+}
+
+func (s *Server) handleDecode(cw *connWriter, id json.RawMessage, raw json.RawMessage) {
+	var p struct {
+		Tokens            []int `json:"tokens"`
+		SkipSpecialTokens bool  `json:"skip_special_tokens"`
+	}
+	if err := json.Unmarshal(raw, &p); err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+	text, err := s.tokenizer.Decode(p.Tokens, p.SkipSpecialTokens)
+	if err != nil {
+		cw.writeError(id, errCodeInternal, err.Error())
+		return
+	}
+	cw.writeResult(id, text)
+}
+
+func (s *Server) handleEncodeWithOffset(cw *connWriter, id json.RawMessage, raw json.RawMessage) {
+	var p struct {
+		Text             string `json:"text"`
+		AddSpecialTokens bool   `json:"add_special_tokens"`
+	}
+	if err := json.Unmarshal(raw, &p); err != nil {
+		cw.writeError(id, errCodeInvalidParams, err.Error())
+		return
+	}
+	tokens, offsets, err := s.tokenizer.EncodeWithOffset(p.Text, p.AddSpecialTokens)
+	if err != nil {
+		cw.writeError(id, errCodeInternal, err.Error())
+		return
+	}
+	cw.writeResult(id, struct {
+		Tokens  []int    `json:"tokens"`
+		Offsets [][2]int `json:"offsets"`
+	}{tokens, offsets})
+}
+
+// connWriter serializes writes of JSON-RPC responses and notifications to
+// a single connection, since a streaming Engine.Generate call pushes
+// several notifications before its final response.
+type connWriter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (c *connWriter) writeResult(id json.RawMessage, result interface{}) {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		c.writeError(id, errCodeInternal, err.Error())
+		return
+	}
+	c.write(Response{JSONRPC: jsonrpcVersion, ID: id, Result: raw})
+}
+
+func (c *connWriter) writeError(id json.RawMessage, code int, message string) {
+	c.write(Response{JSONRPC: jsonrpcVersion, ID: id, Error: &RPCError{Code: code, Message: message}})
+}
+
+func (c *connWriter) writeNotification(method string, params interface{}) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	c.write(Notification{JSONRPC: jsonrpcVersion, Method: method, Params: raw})
+}
+
+func (c *connWriter) write(v interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_ = c.enc.Encode(v)
+}
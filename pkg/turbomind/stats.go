@@ -0,0 +1,60 @@
+package turbomind
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Package-level leak diagnostics, in the spirit of gotch/ts.Tensor's global
+// counters: NewTensor/NewTensorMap/Forward increment these and the
+// corresponding Close decrements them, so a mismatched Close shows up as a
+// non-zero count at shutdown instead of silently leaking C memory.
+var (
+	TensorCount        int64
+	TensorMapCount     int64
+	ForwardResultCount int64
+	AllocatedBytes     int64
+)
+
+// liveTensors tracks every Tensor named via Named, keyed by name, for
+// DumpLiveTensors.
+var liveTensors sync.Map
+
+// TensorInfo describes one live named Tensor, as reported by DumpLiveTensors.
+type TensorInfo struct {
+	Name   string
+	Shape  []int64
+	DType  DataType
+	Memory MemoryType
+}
+
+// StatsSnapshot is a point-in-time read of the package's leak-diagnostic
+// counters, returned by Stats.
+type StatsSnapshot struct {
+	TensorCount        int64
+	TensorMapCount     int64
+	ForwardResultCount int64
+	AllocatedBytes     int64
+}
+
+// Stats returns the current values of TensorCount, TensorMapCount,
+// ForwardResultCount, and AllocatedBytes.
+func Stats() StatsSnapshot {
+	return StatsSnapshot{
+		TensorCount:        atomic.LoadInt64(&TensorCount),
+		TensorMapCount:     atomic.LoadInt64(&TensorMapCount),
+		ForwardResultCount: atomic.LoadInt64(&ForwardResultCount),
+		AllocatedBytes:     atomic.LoadInt64(&AllocatedBytes),
+	}
+}
+
+// DumpLiveTensors returns a TensorInfo for every Tensor currently registered
+// via Named, in no particular order.
+func DumpLiveTensors() []TensorInfo {
+	var infos []TensorInfo
+	liveTensors.Range(func(_, value interface{}) bool {
+		infos = append(infos, value.(TensorInfo))
+		return true
+	})
+	return infos
+}
@@ -0,0 +1,57 @@
+package turbomind
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsReportsCurrentCounters(t *testing.T) {
+	resetStatsForTest(t)
+
+	atomic.AddInt64(&TensorCount, 3)
+	atomic.AddInt64(&TensorMapCount, 1)
+	atomic.AddInt64(&ForwardResultCount, 2)
+	atomic.AddInt64(&AllocatedBytes, 4096)
+
+	snap := Stats()
+	assert.Equal(t, StatsSnapshot{
+		TensorCount:        3,
+		TensorMapCount:     1,
+		ForwardResultCount: 2,
+		AllocatedBytes:     4096,
+	}, snap)
+}
+
+func TestDumpLiveTensorsReportsNamedTensors(t *testing.T) {
+	resetStatsForTest(t)
+
+	liveTensors.Store("a", TensorInfo{Name: "a", Shape: []int64{1, 2}, DType: TypeFP16, Memory: MemoryGPU})
+	liveTensors.Store("b", TensorInfo{Name: "b", Shape: []int64{3}, DType: TypeInt32, Memory: MemoryCPU})
+
+	infos := DumpLiveTensors()
+	assert.Len(t, infos, 2)
+
+	byName := make(map[string]TensorInfo, len(infos))
+	for _, info := range infos {
+		byName[info.Name] = info
+	}
+	assert.Equal(t, TensorInfo{Name: "a", Shape: []int64{1, 2}, DType: TypeFP16, Memory: MemoryGPU}, byName["a"])
+	assert.Equal(t, TensorInfo{Name: "b", Shape: []int64{3}, DType: TypeInt32, Memory: MemoryCPU}, byName["b"])
+}
+
+// resetStatsForTest clears the package-level leak counters and live-tensor
+// registry before a test so it doesn't see state left behind by any test
+// (in this file or another) that ran before it.
+func resetStatsForTest(t *testing.T) {
+	t.Helper()
+	atomic.StoreInt64(&TensorCount, 0)
+	atomic.StoreInt64(&TensorMapCount, 0)
+	atomic.StoreInt64(&ForwardResultCount, 0)
+	atomic.StoreInt64(&AllocatedBytes, 0)
+	liveTensors.Range(func(key, _ interface{}) bool {
+		liveTensors.Delete(key)
+		return true
+	})
+}
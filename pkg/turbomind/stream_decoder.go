@@ -0,0 +1,167 @@
+package turbomind
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// streamDecodeWindow is how many trailing tokens StreamDecoder keeps around
+// to re-decode on every Push. BPE/SentencePiece tokens can merge with their
+// neighbors (a leading "▁" space marker, a multi-byte UTF-8 rune split
+// across byte-fallback tokens, ...), so decoding one token in isolation
+// would corrupt the output; re-decoding a small trailing window and diffing
+// against the previous window's decode is enough to catch those merges
+// without re-decoding the whole sequence on every token.
+const streamDecodeWindow = 6
+
+// byteFallbackTail matches a trailing, not-yet-complete SentencePiece
+// byte-fallback token such as "<0xE2>" or "<0xE2" so it can be held back
+// until the rune it's part of is fully decoded.
+var byteFallbackTail = regexp.MustCompile(`<0x[0-9A-Fa-f]{1,2}>?$`)
+
+// StreamDecoder incrementally decodes a token stream, emitting only the
+// text that has become "stable" -- i.e. that later tokens are guaranteed
+// not to still rewrite. Create one with Tokenizer.NewStreamDecoder for each
+// in-flight generation.
+type StreamDecoder struct {
+	tokenizer         *Tokenizer
+	SkipSpecialTokens bool
+	StopStrings       []string
+
+	window  []int
+	printed string
+	stopped bool
+}
+
+// NewStreamDecoder returns a StreamDecoder bound to this tokenizer.
+// SkipSpecialTokens defaults to true; set StopStrings afterward to suppress
+// output once a stop string is matched.
+func (t *Tokenizer) NewStreamDecoder() *StreamDecoder {
+	return &StreamDecoder{tokenizer: t, SkipSpecialTokens: true}
+}
+
+// Push decodes tokenID in the context of recently pushed tokens and returns
+// the newly stable suffix of text, if any. Once a stop string has been
+// matched, Push is a no-op returning ("", nil) for all subsequent calls.
+func (d *StreamDecoder) Push(tokenID int) (string, error) {
+	if d.stopped {
+		return "", nil
+	}
+
+	d.window = append(d.window, tokenID)
+	if len(d.window) > streamDecodeWindow {
+		d.window = d.window[len(d.window)-streamDecodeWindow:]
+	}
+
+	full, err := d.tokenizer.Decode(d.window, d.SkipSpecialTokens)
+	if err != nil {
+		return "", err
+	}
+
+	var prefix string
+	if len(d.window) > 1 {
+		prefix, err = d.tokenizer.Decode(d.window[:len(d.window)-1], d.SkipSpecialTokens)
+		if err != nil {
+			return "", err
+		}
+	}
+	if !strings.HasPrefix(full, prefix) {
+		// An earlier token got rewritten by this one merging with it
+		// (e.g. a SentencePiece space marker); nothing stable yet.
+		return "", nil
+	}
+
+	delta := full[len(prefix):]
+	if delta == "" || !stableStreamSuffix(delta) {
+		return "", nil
+	}
+
+	return d.emit(delta)
+}
+
+// Flush decodes whatever is left in the window and returns any text not
+// already emitted by Push. Call it once after the final token to release
+// text that Push was holding back as not-yet-stable.
+func (d *StreamDecoder) Flush() string {
+	if d.stopped || len(d.window) == 0 {
+		return ""
+	}
+	full, err := d.tokenizer.Decode(d.window, d.SkipSpecialTokens)
+	if err != nil || !strings.HasPrefix(full, d.printed) {
+		return ""
+	}
+	remainder := full[len(d.printed):]
+	d.printed = full
+	return remainder
+}
+
+// emit applies stop-string suppression to delta before appending it to
+// d.printed, returning the text (if any) the caller should actually see.
+func (d *StreamDecoder) emit(delta string) (string, error) {
+	combined := d.printed + delta
+
+	if cut, hit := earliestStopMatch(combined, d.StopStrings); hit {
+		if cut < len(d.printed) {
+			cut = len(d.printed) // the stop string reaches into already-emitted text; nothing left to claw back
+		}
+		emitted := combined[len(d.printed):cut]
+		d.printed = combined[:cut]
+		d.stopped = true
+		return emitted, nil
+	}
+
+	if endsWithStopPrefix(combined, d.StopStrings) {
+		// The tail could be the start of a stop string; hold it back until
+		// we know whether the rest arrives.
+		return "", nil
+	}
+
+	d.printed = combined
+	return delta, nil
+}
+
+// stableStreamSuffix reports whether delta is safe to emit now: it must be
+// valid UTF-8 (no codepoint split across tokens) and must not end on a
+// partial SentencePiece piece that a following token could still complete.
+func stableStreamSuffix(delta string) bool {
+	if !utf8.ValidString(delta) {
+		return false
+	}
+	if strings.HasSuffix(delta, "▁") { // lone SentencePiece word-boundary marker
+		return false
+	}
+	if byteFallbackTail.MatchString(delta) {
+		return false
+	}
+	return true
+}
+
+// earliestStopMatch returns the index of the earliest occurrence of any
+// stop string in s, and whether one was found.
+func earliestStopMatch(s string, stops []string) (int, bool) {
+	cut := -1
+	for _, stop := range stops {
+		if stop == "" {
+			continue
+		}
+		if idx := strings.Index(s, stop); idx >= 0 && (cut == -1 || idx < cut) {
+			cut = idx
+		}
+	}
+	return cut, cut != -1
+}
+
+// endsWithStopPrefix reports whether s ends with a non-empty proper prefix
+// of any stop string, meaning the stop string might still be completed by
+// subsequent tokens.
+func endsWithStopPrefix(s string, stops []string) bool {
+	for _, stop := range stops {
+		for l := len(stop) - 1; l > 0; l-- {
+			if strings.HasSuffix(s, stop[:l]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package turbomind
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamDecoder(t *testing.T) {
+	modelPath := os.Getenv("TEST_MODEL_PATH")
+	if modelPath == "" {
+		t.Skip("TEST_MODEL_PATH not set, skipping stream decoder tests")
+	}
+
+	t.Run("MatchesFullDecode", func(t *testing.T) {
+		tokenizer, err := NewTokenizer(modelPath)
+		require.NoError(t, err)
+		defer tokenizer.Close()
+
+		text := "The quick brown fox jumps over the lazy dog."
+		tokens, err := tokenizer.Encode(text, false)
+		require.NoError(t, err)
+
+		decoder := tokenizer.NewStreamDecoder()
+		var streamed string
+		for _, tok := range tokens {
+			delta, err := decoder.Push(tok)
+			require.NoError(t, err)
+			streamed += delta
+		}
+		streamed += decoder.Flush()
+
+		full, err := tokenizer.Decode(tokens, true)
+		require.NoError(t, err)
+		assert.Equal(t, full, streamed)
+	})
+
+	t.Run("StopStringTruncates", func(t *testing.T) {
+		tokenizer, err := NewTokenizer(modelPath)
+		require.NoError(t, err)
+		defer tokenizer.Close()
+
+		text := "Hello, world! Goodbye now."
+		tokens, err := tokenizer.Encode(text, false)
+		require.NoError(t, err)
+
+		decoder := tokenizer.NewStreamDecoder()
+		decoder.StopStrings = []string{"Goodbye"}
+
+		var streamed string
+		for _, tok := range tokens {
+			delta, err := decoder.Push(tok)
+			require.NoError(t, err)
+			streamed += delta
+		}
+		streamed += decoder.Flush()
+
+		assert.NotContains(t, streamed, "Goodbye")
+	})
+}
+
+func TestStableStreamSuffix(t *testing.T) {
+	assert.True(t, stableStreamSuffix("hello"))
+	assert.False(t, stableStreamSuffix("he\xC2"))     // truncated multi-byte rune
+	assert.False(t, stableStreamSuffix("word▁"))      // dangling SentencePiece marker
+	assert.False(t, stableStreamSuffix("text<0xE2>")) // unresolved byte-fallback token
+}
@@ -10,18 +10,21 @@ import (
 
 // Tokenizer wraps the sugarme/tokenizer library for TurboMind
 type Tokenizer struct {
-	tokenizer *tokenizer.Tokenizer
-	bosToken  int
-	eosToken  int
-	padToken  int
+	tokenizer   *tokenizer.Tokenizer
+	bosToken    int
+	eosToken    int
+	padToken    int
+	modelDir    string // directory to look for chat_template.json / tokenizer_config.json
+	modelFamily string // fallback key into builtinChatTemplates when modelDir has none
 }
 
 // TokenizerConfig holds tokenizer configuration
 type TokenizerConfig struct {
 	TokenizerPath string // Path to tokenizer.json file
 	BosToken      int    // Beginning of sequence token
-	EosToken      int    // End of sequence token  
+	EosToken      int    // End of sequence token
 	PadToken      int    // Padding token
+	ModelFamily   string // Chat template family to fall back to (llama, phi, qwen, chatml, mistral)
 }
 
 // NewTokenizer creates a new tokenizer from the model directory
@@ -38,6 +41,7 @@ func NewTokenizer(modelDir string) (*Tokenizer, error) {
 		bosToken:  1,     // <s>
 		eosToken:  32000, // <|endoftext|>
 		padToken:  32000, // <|endoftext|> (same as EOS for this model)
+		modelDir:  modelDir,
 	}, nil
 }
 
@@ -49,10 +53,11 @@ func NewTokenizerWithConfig(config *TokenizerConfig) (*Tokenizer, error) {
 	}
 	
 	return &Tokenizer{
-		tokenizer: tk,
-		bosToken:  config.BosToken,
-		eosToken:  config.EosToken,
-		padToken:  config.PadToken,
+		tokenizer:   tk,
+		bosToken:    config.BosToken,
+		eosToken:    config.EosToken,
+		padToken:    config.PadToken,
+		modelFamily: config.ModelFamily,
 	}, nil
 }
 
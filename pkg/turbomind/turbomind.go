@@ -1,9 +1,13 @@
 package turbomind
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/ebitengine/purego"
@@ -16,7 +20,41 @@ import "C"
 
 // Engine handle
 type Engine struct {
-	handle uintptr
+	handle  uintptr
+	metrics engineCounters
+	events  *eventBus
+}
+
+// engineCounters aggregates per-request Usage across the lifetime of an
+// Engine for Prometheus-style scraping via Engine.Metrics. Fields are
+// updated with atomic ops since requests stream concurrently.
+type engineCounters struct {
+	requestsTotal           int64
+	errorsTotal             int64
+	promptTokensTotal       int64
+	completionTokensTotal   int64
+	cachedPromptTokensTotal int64
+}
+
+// EngineMetrics is a point-in-time snapshot of Engine.Metrics.
+type EngineMetrics struct {
+	RequestsTotal           int64
+	ErrorsTotal             int64
+	PromptTokensTotal       int64
+	CompletionTokensTotal   int64
+	CachedPromptTokensTotal int64
+}
+
+// Metrics returns a snapshot of request and token counters accumulated
+// across every Generate/GenerateStream call so far.
+func (e *Engine) Metrics() EngineMetrics {
+	return EngineMetrics{
+		RequestsTotal:           atomic.LoadInt64(&e.metrics.requestsTotal),
+		ErrorsTotal:             atomic.LoadInt64(&e.metrics.errorsTotal),
+		PromptTokensTotal:       atomic.LoadInt64(&e.metrics.promptTokensTotal),
+		CompletionTokensTotal:   atomic.LoadInt64(&e.metrics.completionTokensTotal),
+		CachedPromptTokensTotal: atomic.LoadInt64(&e.metrics.cachedPromptTokensTotal),
+	}
 }
 
 // Configuration for TurboMind engine
@@ -42,6 +80,8 @@ type RequestParams struct {
 	TopP              float32
 	TopK              float32
 	RepetitionPenalty int
+	PresencePenalty   float32
+	FrequencyPenalty  float32
 	Stream            bool
 	StopWords         string // JSON array string
 }
@@ -52,11 +92,37 @@ type ResponseData struct {
 	Text         string
 	InputTokens  int
 	OutputTokens int
+	Usage        Usage
 	Finished     bool
 	ErrorCode    int
 	ErrorMessage string
 }
 
+// Usage reports token accounting for a single request. CachedPromptTokens
+// is populated only when the engine reports a KV-cache prefix hit;
+// FirstTokenLatencyMs and TokensPerSecond are measured wall-clock from the
+// moment GenerateStream issues the request.
+type Usage struct {
+	PromptTokens        int
+	CompletionTokens    int
+	TotalTokens         int
+	CachedPromptTokens  int
+	FirstTokenLatencyMs float64
+	TokensPerSecond     float64
+}
+
+// TokenDelta is one increment of streamed generation output, as produced by
+// Engine.GenerateStream. FinishReason is empty until the stream ends, at
+// which point it is "stop" or "error"; Usage is populated only
+// on the final delta.
+type TokenDelta struct {
+	Text         string
+	TokenID      int
+	Index        int
+	FinishReason string
+	Usage        *Usage
+}
+
 // Version information
 type VersionInfo struct {
 	Version     string
@@ -97,18 +163,21 @@ type cRequestParams struct {
 	topP              C.float
 	topK              C.float
 	repetitionPenalty C.int
+	presencePenalty   C.float
+	frequencyPenalty  C.float
 	stream            C.bool
 	stopWords         *C.char
 }
 
 type cResponseData struct {
-	requestId    C.int64_t
-	text         *C.char
-	inputTokens  C.int
-	outputTokens C.int
-	finished     C.bool
-	errorCode    C.int
-	errorMessage *C.char
+	requestId          C.int64_t
+	text               *C.char
+	inputTokens        C.int
+	outputTokens       C.int
+	cachedPromptTokens C.int
+	finished           C.bool
+	errorCode          C.int
+	errorMessage       *C.char
 }
 
 type cVersionInfo struct {
@@ -137,6 +206,7 @@ var (
 	turbomindGenerateAsync    func(engine uintptr, request uintptr) int
 	turbomindGetResponse      func(engine uintptr, requestId int64, response uintptr) int
 	turbomindGenerateBatch    func(engine uintptr, requests uintptr, batchSize int, responses uintptr) int
+	turbomindCancelRequest    func(engine uintptr, requestId int64) int
 	turbomindGetVersion       func(info uintptr) int
 	turbomindFreeResponse     func(response uintptr)
 	turbomindGetLastError     func() uintptr
@@ -165,6 +235,7 @@ func Initialize(libraryPath string) error {
 	purego.RegisterLibFunc(&turbomindGenerateAsync, lib, "turbomind_generate_async")
 	purego.RegisterLibFunc(&turbomindGetResponse, lib, "turbomind_get_response")
 	purego.RegisterLibFunc(&turbomindGenerateBatch, lib, "turbomind_generate_batch")
+	purego.RegisterLibFunc(&turbomindCancelRequest, lib, "turbomind_cancel_request")
 	purego.RegisterLibFunc(&turbomindGetVersion, lib, "turbomind_get_version")
 	purego.RegisterLibFunc(&turbomindFreeResponse, lib, "turbomind_free_response")
 	purego.RegisterLibFunc(&turbomindGetLastError, lib, "turbomind_get_last_error")
@@ -201,7 +272,7 @@ func NewEngine(config Config) (*Engine, error) {
 		return nil, fmt.Errorf("failed to create engine: %s", GetLastError())
 	}
 
-	engine := &Engine{handle: handle}
+	engine := &Engine{handle: handle, events: newEventBus()}
 	runtime.SetFinalizer(engine, (*Engine).Close)
 	return engine, nil
 }
@@ -214,13 +285,49 @@ func (e *Engine) IsReady() bool {
 	return turbomindIsEngineReady(e.handle)
 }
 
-// Generate performs inference
+// Generate performs inference and blocks until the full response is ready.
+// It is a thin wrapper around GenerateStream that drains the channel and
+// reassembles the deltas into a single ResponseData.
 func (e *Engine) Generate(params RequestParams) (*ResponseData, error) {
+	deltas, err := e.GenerateStream(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResponseData{RequestID: params.RequestID}
+	var text strings.Builder
+	for delta := range deltas {
+		text.WriteString(delta.Text)
+		if delta.FinishReason != "" {
+			response.Finished = true
+			if delta.FinishReason == "error" {
+				response.ErrorCode = 1
+				response.ErrorMessage = delta.Text
+			}
+		}
+		if delta.Usage != nil {
+			response.Usage = *delta.Usage
+			response.InputTokens = delta.Usage.PromptTokens
+			response.OutputTokens = delta.Usage.CompletionTokens
+		}
+	}
+	if response.ErrorCode == 0 {
+		response.Text = text.String()
+	}
+
+	return response, nil
+}
+
+// GenerateStream performs streaming inference, pushing a TokenDelta to the
+// returned channel for each new increment of output until the request
+// finishes or ctx is cancelled. The channel is always closed before
+// GenerateStream's background goroutine exits. Cancelling ctx aborts the
+// underlying inference request via cancel_request.
+func (e *Engine) GenerateStream(ctx context.Context, params RequestParams) (<-chan TokenDelta, error) {
 	if e.handle == 0 {
 		return nil, errors.New("engine is closed")
 	}
 
-	// Convert params to C struct
 	cRequest := cRequestParams{
 		requestId:         C.int64_t(params.RequestID),
 		prompt:            C.CString(params.Prompt),
@@ -229,39 +336,144 @@ func (e *Engine) Generate(params RequestParams) (*ResponseData, error) {
 		topP:              C.float(params.TopP),
 		topK:              C.float(params.TopK),
 		repetitionPenalty: C.int(params.RepetitionPenalty),
-		stream:            C.bool(params.Stream),
+		presencePenalty:   C.float(params.PresencePenalty),
+		frequencyPenalty:  C.float(params.FrequencyPenalty),
+		stream:            C.bool(true),
 		stopWords:         C.CString(params.StopWords),
 	}
-
 	defer func() {
 		C.free(unsafe.Pointer(cRequest.prompt))
 		C.free(unsafe.Pointer(cRequest.stopWords))
 	}()
 
-	var cResponse cResponseData
-	result := turbomindGenerate(e.handle, uintptr(unsafe.Pointer(&cRequest)), uintptr(unsafe.Pointer(&cResponse)))
-	if result != 0 {
-		return nil, fmt.Errorf("generation failed: %s", GetLastError())
+	if result := turbomindGenerateAsync(e.handle, uintptr(unsafe.Pointer(&cRequest))); result != 0 {
+		return nil, fmt.Errorf("failed to start generation: %s", GetLastError())
 	}
 
-	// Convert response
-	response := &ResponseData{
-		RequestID:    int64(cResponse.requestId),
-		Text:         C.GoString(cResponse.text),
-		InputTokens:  int(cResponse.inputTokens),
-		OutputTokens: int(cResponse.outputTokens),
-		Finished:     bool(cResponse.finished),
-		ErrorCode:    int(cResponse.errorCode),
-	}
+	out := make(chan TokenDelta)
+	go e.streamResponses(ctx, params.RequestID, time.Now(), out)
+	return out, nil
+}
+
+// streamResponses polls turbomind_get_response until the request finishes,
+// is cancelled, or errors, converting each new increment of text into a
+// TokenDelta. It owns `out` and always closes it before returning. start is
+// used to derive Usage.FirstTokenLatencyMs and Usage.TokensPerSecond, and to
+// update the engine's aggregate Metrics().
+func (e *Engine) streamResponses(ctx context.Context, requestID int64, start time.Time, out chan<- TokenDelta) {
+	defer close(out)
+
+	var accumulated string
+	var firstTokenLatency time.Duration
+	firstTokenSeen := false
+	index := 0
+	for {
+		select {
+		case <-ctx.Done():
+			if turbomindCancelRequest != nil {
+				turbomindCancelRequest(e.handle, requestID)
+			}
+			return
+		default:
+		}
+
+		var cResponse cResponseData
+		result := turbomindGetResponse(e.handle, requestID, uintptr(unsafe.Pointer(&cResponse)))
+		if result != 0 {
+			send(ctx, out, TokenDelta{Index: index, FinishReason: "error", Text: GetLastError()})
+			return
+		}
+
+		text := C.GoString(cResponse.text)
+		delta := strings.TrimPrefix(text, accumulated)
+		accumulated = text
+		finished := bool(cResponse.finished)
+		errorCode := int(cResponse.errorCode)
+
+		if delta != "" && !firstTokenSeen {
+			firstTokenLatency = time.Since(start)
+			firstTokenSeen = true
+		}
+
+		td := TokenDelta{Text: delta, TokenID: -1, Index: index}
+		if errorCode != 0 {
+			td.FinishReason = "error"
+			if cResponse.errorMessage != nil {
+				td.Text = C.GoString(cResponse.errorMessage)
+			}
+			atomic.AddInt64(&e.metrics.errorsTotal, 1)
+		} else if finished {
+			elapsed := time.Since(start)
+			completionTokens := int(cResponse.outputTokens)
+			tokensPerSecond := 0.0
+			if elapsed > 0 {
+				tokensPerSecond = float64(completionTokens) / elapsed.Seconds()
+			}
+			td.FinishReason = "stop"
+			td.Usage = &Usage{
+				PromptTokens:        int(cResponse.inputTokens),
+				CompletionTokens:    completionTokens,
+				TotalTokens:         int(cResponse.inputTokens) + completionTokens,
+				CachedPromptTokens:  int(cResponse.cachedPromptTokens),
+				FirstTokenLatencyMs: float64(firstTokenLatency) / float64(time.Millisecond),
+				TokensPerSecond:     tokensPerSecond,
+			}
+			atomic.AddInt64(&e.metrics.requestsTotal, 1)
+			atomic.AddInt64(&e.metrics.promptTokensTotal, int64(td.Usage.PromptTokens))
+			atomic.AddInt64(&e.metrics.completionTokensTotal, int64(completionTokens))
+			atomic.AddInt64(&e.metrics.cachedPromptTokensTotal, int64(td.Usage.CachedPromptTokens))
+		}
+		turbomindFreeResponse(uintptr(unsafe.Pointer(&cResponse)))
 
-	if cResponse.errorMessage != nil {
-		response.ErrorMessage = C.GoString(cResponse.errorMessage)
+		if delta == "" && td.FinishReason == "" {
+			// Nothing new yet; avoid busy-spinning on turbomind_get_response.
+			time.Sleep(5 * time.Millisecond)
+			continue
+		}
+
+		index++
+		e.events.publish(requestID, responseSnapshot(requestID, accumulated, td))
+		if !send(ctx, out, td) {
+			if turbomindCancelRequest != nil {
+				turbomindCancelRequest(e.handle, requestID)
+			}
+			return
+		}
+		if td.FinishReason != "" {
+			return
+		}
 	}
+}
 
-	// Free C response
-	turbomindFreeResponse(uintptr(unsafe.Pointer(&cResponse)))
+// responseSnapshot builds the ResponseData an Engine.Subscribe/SubscribeAll
+// caller sees for one TokenDelta: the cumulative text so far plus whatever
+// that delta carried.
+func responseSnapshot(requestID int64, accumulated string, td TokenDelta) ResponseData {
+	resp := ResponseData{RequestID: requestID, Text: accumulated}
+	if td.FinishReason == "error" {
+		resp.Finished = true
+		resp.ErrorCode = 1
+		resp.ErrorMessage = td.Text
+	} else if td.FinishReason != "" {
+		resp.Finished = true
+	}
+	if td.Usage != nil {
+		resp.Usage = *td.Usage
+		resp.InputTokens = td.Usage.PromptTokens
+		resp.OutputTokens = td.Usage.CompletionTokens
+	}
+	return resp
+}
 
-	return response, nil
+// send delivers a TokenDelta to out, returning false if ctx was cancelled
+// first instead.
+func send(ctx context.Context, out chan<- TokenDelta, td TokenDelta) bool {
+	select {
+	case out <- td:
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
 
 // GetModelInfo returns model information
@@ -297,6 +509,7 @@ func (e *Engine) Close() {
 		turbomindDestroyEngine(e.handle)
 		e.handle = 0
 		runtime.SetFinalizer(e, nil)
+		e.events.close()
 	}
 }
 
@@ -10,12 +10,64 @@ package turbomind
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"fmt"
 	"runtime"
+	"sync"
+	"sync/atomic"
 	"unsafe"
 )
 
+// ErrInstanceClosed is returned by ModelInstance methods called after Close.
+var ErrInstanceClosed = errors.New("turbomind: model instance is closed")
+
+// lifecycle guards a cgo handle against use-after-close, the way
+// TensorFlow's Go Session guards its C session handle: every method that
+// touches the handle calls enter before and leave after (typically via
+// defer), and shutdown marks the lifecycle closed - so enter starts
+// rejecting callers - then waits for every in-flight enter/leave pair to
+// finish before the caller destroys the handle.
+type lifecycle struct {
+	mu     sync.Mutex
+	wg     sync.WaitGroup
+	closed bool
+}
+
+// enter registers one in-flight handle access, returning closedErr without
+// registering anything if shutdown has already run.
+func (l *lifecycle) enter(closedErr error) error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return closedErr
+	}
+	l.wg.Add(1)
+	l.mu.Unlock()
+	return nil
+}
+
+// leave ends one access registered by enter.
+func (l *lifecycle) leave() {
+	l.wg.Done()
+}
+
+// shutdown marks the lifecycle closed so no further enter succeeds, waits
+// for in-flight accesses to leave, then runs destroy to release the
+// handle. Safe to call more than once; destroy only runs the first time.
+func (l *lifecycle) shutdown(destroy func()) {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	l.closed = true
+	l.mu.Unlock()
+
+	l.wg.Wait()
+	destroy()
+}
+
 // DataType represents TurboMind data types
 type DataType int
 
@@ -59,11 +111,13 @@ const (
 // Model represents a TurboMind model
 type Model struct {
 	handle *C.TurboMindModel
+	life   lifecycle
 }
 
 // ModelInstance represents a model instance for inference
 type ModelInstance struct {
 	handle *C.TurboMindModelInstance
+	life   lifecycle
 }
 
 // Tensor represents a TurboMind tensor
@@ -72,11 +126,33 @@ type Tensor struct {
 	shape  []int64
 	dtype  DataType
 	memory MemoryType
+	// life is this Tensor's own lifecycle when it owns its handle, or the
+	// owning TensorMap's lifecycle when borrowed - so a borrowed Tensor's
+	// enter/leave register against the owner's wait group and the owner's
+	// Close can't free the handle out from under a concurrent CopyToHost.
+	life *lifecycle
+
+	// Name optionally identifies this tensor in DumpLiveTensors; set it
+	// with Named.
+	Name string
+
+	sizeBytes int64
+	// borrowed marks a Tensor returned by TensorMap.Get: it shares the
+	// map's handle rather than owning one, so Close must not destroy it.
+	borrowed bool
 }
 
 // TensorMap represents a collection of tensors
 type TensorMap struct {
 	handle *C.TurboMindTensorMap
+	// life is this TensorMap's own lifecycle when it owns its handle, or
+	// the owning ForwardResult's lifecycle when borrowed - see Tensor.life.
+	life *lifecycle
+
+	// borrowed marks a TensorMap returned by ForwardResult.OutputTensors: it
+	// shares the forward result's handle rather than owning one, so Close
+	// must not destroy it.
+	borrowed bool
 }
 
 // Session represents inference session parameters
@@ -111,6 +187,7 @@ type ForwardResult struct {
 	Tensors *TensorMap
 	Status  RequestStatus
 	SeqLen  int
+	life    lifecycle
 }
 
 // NewModel creates a new TurboMind model
@@ -132,7 +209,7 @@ func NewModel(modelDir, config, weightType string) (*Model, error) {
 	
 	handle := C.turbomind_create_model(cModelDir, cConfig, cWeightType)
 	if handle == nil {
-		return nil, fmt.Errorf("failed to create model: %s", GetLastError())
+		return nil, fmt.Errorf("failed to create model: %s", getLastInstanceError())
 	}
 	
 	model := &Model{handle: handle}
@@ -142,34 +219,42 @@ func NewModel(modelDir, config, weightType string) (*Model, error) {
 
 // Close destroys the model
 func (m *Model) Close() {
-	if m.handle != nil {
-		C.turbomind_destroy_model(m.handle)
-		m.handle = nil
-		runtime.SetFinalizer(m, nil)
-	}
+	m.life.shutdown(func() {
+		if m.handle != nil {
+			C.turbomind_destroy_model(m.handle)
+			m.handle = nil
+		}
+	})
+	runtime.SetFinalizer(m, nil)
 }
 
-// CreateInstance creates a model instance for inference
-func (m *Model) CreateInstance(deviceID int) (*ModelInstance, error) {
-	if m.handle == nil {
-		return nil, errors.New("model is closed")
+// CreateInstance creates a model instance for inference on deviceID,
+// taking on rank's position in the model's tensor/pipeline-parallel group
+// (rank 0, deviceID 0 for a single-GPU model). NewInstanceGroup calls this
+// once per rank to build a sharded group; callers that only need one GPU
+// can pass rank 0 directly.
+func (m *Model) CreateInstance(deviceID, rank int) (*ModelInstance, error) {
+	if err := m.life.enter(errors.New("model is closed")); err != nil {
+		return nil, err
 	}
-	
-	// Step 1: Create shared weights (uses rank index - must be 0 for single GPU)
-	C.turbomind_create_shared_weights(m.handle, C.int(0), C.int(0)) // device_id=0, rank=0 for single GPU
-	
-	// Step 2: Process weights (uses device_id index - must be 0 for single GPU)
-	C.turbomind_process_weights(m.handle, C.int(0), C.int(0)) // device_id=0, rank=0 for single GPU
-	
-	// Step 3: Create engine (uses device_id index - must be 0 for single GPU) 
-	C.turbomind_create_engine(m.handle, C.int(0), C.int(0)) // device_id=0, rank=0 for single GPU
-	
-	// Step 3: Create model instance
+	defer m.life.leave()
+
+	// Step 1: Create shared weights for this rank's shard of the model.
+	C.turbomind_create_shared_weights(m.handle, C.int(deviceID), C.int(rank))
+
+	// Step 2: Process weights onto deviceID.
+	C.turbomind_process_weights(m.handle, C.int(deviceID), C.int(rank))
+
+	// Step 3: Create the engine for this rank, joining the model's NCCL
+	// communicator group alongside every other rank's CreateInstance call.
+	C.turbomind_create_engine(m.handle, C.int(deviceID), C.int(rank))
+
+	// Step 4: Create model instance
 	handle := C.turbomind_create_model_instance(m.handle, C.int(deviceID))
 	if handle == nil {
-		return nil, fmt.Errorf("failed to create model instance: %s", GetLastError())
+		return nil, fmt.Errorf("failed to create model instance: %s", getLastInstanceError())
 	}
-	
+
 	instance := &ModelInstance{handle: handle}
 	runtime.SetFinalizer(instance, (*ModelInstance).Close)
 	return instance, nil
@@ -177,119 +262,325 @@ func (m *Model) CreateInstance(deviceID int) (*ModelInstance, error) {
 
 // GetTensorParaSize returns tensor parallelism size
 func (m *Model) GetTensorParaSize() int {
-	if m.handle == nil {
+	if err := m.life.enter(errors.New("model is closed")); err != nil {
 		return -1
 	}
+	defer m.life.leave()
 	return int(C.turbomind_get_tensor_para_size(m.handle))
 }
 
 // GetPipelineParaSize returns pipeline parallelism size
 func (m *Model) GetPipelineParaSize() int {
-	if m.handle == nil {
+	if err := m.life.enter(errors.New("model is closed")); err != nil {
 		return -1
 	}
+	defer m.life.leave()
 	return int(C.turbomind_get_pipeline_para_size(m.handle))
 }
 
 // Close destroys the model instance
 func (mi *ModelInstance) Close() {
-	if mi.handle != nil {
-		C.turbomind_destroy_model_instance(mi.handle)
-		mi.handle = nil
-		runtime.SetFinalizer(mi, nil)
-	}
+	mi.life.shutdown(func() {
+		if mi.handle != nil {
+			C.turbomind_destroy_model_instance(mi.handle)
+			mi.handle = nil
+		}
+	})
+	runtime.SetFinalizer(mi, nil)
 }
 
 // Forward performs forward inference
 func (mi *ModelInstance) Forward(inputTensors *TensorMap, session *Session, genConfig *GenerationConfig, streamOutput bool) (*ForwardResult, error) {
-	if mi.handle == nil {
-		return nil, errors.New("model instance is closed")
+	if err := mi.life.enter(ErrInstanceClosed); err != nil {
+		return nil, err
 	}
-	
-	// Convert session
-	cSession := C.TurboMindSession{
-		id:         C.uint64_t(session.ID),
-		step:       C.int(session.Step),
-		start_flag: C.bool(session.StartFlag),
-		end_flag:   C.bool(session.EndFlag),
+	defer mi.life.leave()
+
+	cSession := newCSession(session)
+	cGenConfig := newCGenerationConfig(genConfig)
+	defer cGenConfig.free()
+
+	handle := C.turbomind_forward(mi.handle, inputTensors.handle, &cSession, &cGenConfig.value, C.bool(streamOutput))
+	if handle == nil {
+		return nil, fmt.Errorf("forward inference failed: %s", getLastInstanceError())
 	}
-	
-	// Convert generation config
-	cGenConfig := C.TurboMindGenerationConfig{
-		max_new_tokens:              C.int(genConfig.MaxNewTokens),
-		min_new_tokens:              C.int(genConfig.MinNewTokens),
-		top_p:                       C.float(genConfig.TopP),
-		top_k:                       C.int(genConfig.TopK),
-		min_p:                       C.float(genConfig.MinP),
-		temperature:                 C.float(genConfig.Temperature),
-		repetition_penalty:          C.float(genConfig.RepetitionPenalty),
-		random_seed:                 C.uint64_t(genConfig.RandomSeed),
-		output_logprobs:             C.bool(genConfig.OutputLogprobs),
-		output_last_hidden_state:    C.bool(genConfig.OutputLastHiddenState),
-		output_logits:               C.bool(genConfig.OutputLogits),
+
+	result := &ForwardResult{handle: handle}
+	runtime.SetFinalizer(result, (*ForwardResult).Close)
+	atomic.AddInt64(&ForwardResultCount, 1)
+	return result, nil
+}
+
+// ForwardBatch submits every request in requests as one continuous-batching
+// forward call instead of looping over Forward: it packs requests into
+// TurboMind's [batch, seq] layout, padding input_ids to the batch's
+// longest sequence and building a matching sequence_length tensor so the
+// engine can tell real tokens from padding, then splits the resulting
+// batch handle back into one ForwardResult per request via
+// turbomind_forward_batch_result, in request order.
+func (mi *ModelInstance) ForwardBatch(requests []*BatchRequest) ([]*ForwardResult, error) {
+	if err := mi.life.enter(ErrInstanceClosed); err != nil {
+		return nil, err
 	}
-	
-	// Convert arrays
-	var cEosIds *C.int
-	var cStopIds *C.int
-	var cBadIds *C.int
-	
-	if len(genConfig.EosIds) > 0 {
-		cEosIds = (*C.int)(C.malloc(C.size_t(len(genConfig.EosIds)) * C.sizeof_int))
-		defer C.free(unsafe.Pointer(cEosIds))
-		cEosSlice := (*[1 << 30]C.int)(unsafe.Pointer(cEosIds))
-		for i, id := range genConfig.EosIds {
-			cEosSlice[i] = C.int(id)
-		}
-		cGenConfig.eos_ids = cEosIds
-		cGenConfig.eos_ids_count = C.int(len(genConfig.EosIds))
+	defer mi.life.leave()
+
+	if len(requests) == 0 {
+		return nil, errors.New("turbomind: empty batch")
 	}
-	
-	if len(genConfig.StopIds) > 0 {
-		cStopIds = (*C.int)(C.malloc(C.size_t(len(genConfig.StopIds)) * C.sizeof_int))
-		defer C.free(unsafe.Pointer(cStopIds))
-		cStopSlice := (*[1 << 30]C.int)(unsafe.Pointer(cStopIds))
-		for i, id := range genConfig.StopIds {
-			cStopSlice[i] = C.int(id)
-		}
-		cGenConfig.stop_ids = cStopIds
-		cGenConfig.stop_ids_count = C.int(len(genConfig.StopIds))
+
+	inputIDs, seqLens, maxLen := packBatch(requests)
+	if maxLen == 0 {
+		return nil, errors.New("turbomind: batch requests all have empty token lists")
 	}
-	
-	if len(genConfig.BadIds) > 0 {
-		cBadIds = (*C.int)(C.malloc(C.size_t(len(genConfig.BadIds)) * C.sizeof_int))
-		defer C.free(unsafe.Pointer(cBadIds))
-		cBadSlice := (*[1 << 30]C.int)(unsafe.Pointer(cBadIds))
-		for i, id := range genConfig.BadIds {
-			cBadSlice[i] = C.int(id)
+	batchSize := len(requests)
+
+	inputTensor, err := NewTensor(unsafe.Pointer(&inputIDs[0]), []int64{int64(batchSize), int64(maxLen)}, TypeInt32, MemoryGPU, 0)
+	if err != nil {
+		return nil, fmt.Errorf("turbomind: create batch input tensor: %w", err)
+	}
+	defer inputTensor.Close()
+
+	seqLenTensor, err := NewTensor(unsafe.Pointer(&seqLens[0]), []int64{int64(batchSize)}, TypeInt32, MemoryGPU, 0)
+	if err != nil {
+		return nil, fmt.Errorf("turbomind: create batch sequence length tensor: %w", err)
+	}
+	defer seqLenTensor.Close()
+
+	tensorMap := NewTensorMap()
+	if tensorMap == nil {
+		return nil, errors.New("turbomind: failed to create batch tensor map")
+	}
+	defer tensorMap.Close()
+	if err := tensorMap.Set("input_ids", inputTensor); err != nil {
+		return nil, fmt.Errorf("turbomind: set batch input tensor: %w", err)
+	}
+	if err := tensorMap.Set("sequence_length", seqLenTensor); err != nil {
+		return nil, fmt.Errorf("turbomind: set batch sequence length tensor: %w", err)
+	}
+
+	cSessions := make([]C.TurboMindSession, batchSize)
+	cGenConfigs := make([]cGenerationConfig, batchSize)
+	cGenConfigValues := make([]C.TurboMindGenerationConfig, batchSize)
+	for i, req := range requests {
+		cSessions[i] = newCSession(req.Session)
+		cGenConfigs[i] = newCGenerationConfig(req.GenConfig)
+		cGenConfigValues[i] = cGenConfigs[i].value
+	}
+	defer func() {
+		for _, cfg := range cGenConfigs {
+			cfg.free()
 		}
-		cGenConfig.bad_ids = cBadIds
-		cGenConfig.bad_ids_count = C.int(len(genConfig.BadIds))
+	}()
+
+	batchHandle := C.turbomind_forward_batch(mi.handle, tensorMap.handle, &cSessions[0], &cGenConfigValues[0], C.int(batchSize))
+	if batchHandle == nil {
+		return nil, fmt.Errorf("turbomind: batch forward failed: %s", getLastInstanceError())
+	}
+	defer C.turbomind_destroy_forward_result(batchHandle)
+
+	results := make([]*ForwardResult, batchSize)
+	for i := range requests {
+		handle := C.turbomind_forward_batch_result(batchHandle, C.int(i))
+		if handle == nil {
+			for _, r := range results {
+				if r != nil {
+					r.Close()
+				}
+			}
+			return nil, fmt.Errorf("turbomind: split batch result %d: %s", i, getLastInstanceError())
+		}
+		result := &ForwardResult{handle: handle}
+		runtime.SetFinalizer(result, (*ForwardResult).Close)
+		atomic.AddInt64(&ForwardResultCount, 1)
+		results[i] = result
 	}
-	
-	// Call forward
-	handle := C.turbomind_forward(mi.handle, inputTensors.handle, &cSession, &cGenConfig, C.bool(streamOutput))
+
+	return results, nil
+}
+
+// StreamChunk is one incremental chunk of tokens from ForwardStream.
+type StreamChunk struct {
+	Tokens   []int32
+	LogProbs []float32
+	Finished bool
+	Err      error
+}
+
+// BatchRequest is one request within a ForwardBatch call: its own input
+// tokens, session, and generation config, packed alongside the rest of the
+// batch into a single continuous-batching forward call.
+type BatchRequest struct {
+	Tokens    []int32
+	Session   *Session
+	GenConfig *GenerationConfig
+}
+
+// ForwardStream starts streaming inference and returns a channel of
+// StreamChunk, one per intermediate token tensor turbomind_forward_stream
+// pushes, closing the channel once a Finished chunk is sent, an error
+// occurs, or ctx is cancelled. Cancelling ctx calls Cancel on mi so the
+// underlying C request stops producing further chunks.
+func (mi *ModelInstance) ForwardStream(ctx context.Context, inputTensors *TensorMap, session *Session, genConfig *GenerationConfig) (<-chan *StreamChunk, error) {
+	if err := mi.life.enter(ErrInstanceClosed); err != nil {
+		return nil, err
+	}
+
+	cSession := newCSession(session)
+	cGenConfig := newCGenerationConfig(genConfig)
+	defer cGenConfig.free()
+
+	handle := C.turbomind_forward_stream(mi.handle, inputTensors.handle, &cSession, &cGenConfig.value)
 	if handle == nil {
-		return nil, fmt.Errorf("forward inference failed: %s", GetLastError())
+		mi.life.leave()
+		return nil, fmt.Errorf("streaming forward failed: %s", getLastInstanceError())
+	}
+
+	out := make(chan *StreamChunk)
+	go mi.streamChunks(ctx, handle, out)
+	return out, nil
+}
+
+// streamChunks polls turbomind_forward_next_chunk until a chunk reports
+// Finished, an error occurs, or ctx is cancelled, converting each one into
+// a StreamChunk. It owns out and the lifecycle entry ForwardStream
+// acquired, and always closes/releases both before returning, along with
+// the forward handle itself.
+func (mi *ModelInstance) streamChunks(ctx context.Context, handle *C.TurboMindForwardResult, out chan<- *StreamChunk) {
+	defer close(out)
+	defer mi.life.leave()
+	defer C.turbomind_destroy_forward_result(handle)
+
+	for {
+		select {
+		case <-ctx.Done():
+			mi.Cancel()
+			return
+		default:
+		}
+
+		var cChunk C.TurboMindStreamChunk
+		if result := C.turbomind_forward_next_chunk(handle, &cChunk); result != 0 {
+			sendChunk(ctx, out, &StreamChunk{Err: fmt.Errorf("forward stream failed: %s", getLastInstanceError())})
+			return
+		}
+
+		chunk := &StreamChunk{Finished: bool(cChunk.finished)}
+		if cChunk.tokens_count > 0 {
+			tokens := (*[1 << 30]C.int32_t)(unsafe.Pointer(cChunk.tokens))[:cChunk.tokens_count:cChunk.tokens_count]
+			chunk.Tokens = make([]int32, len(tokens))
+			for i, t := range tokens {
+				chunk.Tokens[i] = int32(t)
+			}
+		}
+		if cChunk.logprobs_count > 0 {
+			logProbs := (*[1 << 30]C.float)(unsafe.Pointer(cChunk.logprobs))[:cChunk.logprobs_count:cChunk.logprobs_count]
+			chunk.LogProbs = make([]float32, len(logProbs))
+			for i, lp := range logProbs {
+				chunk.LogProbs[i] = float32(lp)
+			}
+		}
+		C.turbomind_free_stream_chunk(&cChunk)
+
+		if !sendChunk(ctx, out, chunk) {
+			mi.Cancel()
+			return
+		}
+		if chunk.Finished {
+			return
+		}
+	}
+}
+
+// sendChunk delivers chunk to out, returning false if ctx was cancelled
+// first instead.
+func sendChunk(ctx context.Context, out chan<- *StreamChunk, chunk *StreamChunk) bool {
+	select {
+	case out <- chunk:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// newCSession converts a Session to its C form.
+func newCSession(session *Session) C.TurboMindSession {
+	return C.TurboMindSession{
+		id:         C.uint64_t(session.ID),
+		step:       C.int(session.Step),
+		start_flag: C.bool(session.StartFlag),
+		end_flag:   C.bool(session.EndFlag),
+	}
+}
+
+// cGenerationConfig is a GenerationConfig converted to its C form, plus
+// the malloc'd id arrays it points into; call free once the C call using
+// value has returned.
+type cGenerationConfig struct {
+	value C.TurboMindGenerationConfig
+	free  func()
+}
+
+// newCGenerationConfig converts genConfig to its C form, malloc'ing one
+// array per non-empty id list.
+func newCGenerationConfig(genConfig *GenerationConfig) cGenerationConfig {
+	cfg := C.TurboMindGenerationConfig{
+		max_new_tokens:           C.int(genConfig.MaxNewTokens),
+		min_new_tokens:           C.int(genConfig.MinNewTokens),
+		top_p:                    C.float(genConfig.TopP),
+		top_k:                    C.int(genConfig.TopK),
+		min_p:                    C.float(genConfig.MinP),
+		temperature:              C.float(genConfig.Temperature),
+		repetition_penalty:       C.float(genConfig.RepetitionPenalty),
+		random_seed:              C.uint64_t(genConfig.RandomSeed),
+		output_logprobs:          C.bool(genConfig.OutputLogprobs),
+		output_last_hidden_state: C.bool(genConfig.OutputLastHiddenState),
+		output_logits:            C.bool(genConfig.OutputLogits),
+	}
+
+	var allocated []unsafe.Pointer
+	setIDs := func(ids []int, dst **C.int, count *C.int) {
+		if len(ids) == 0 {
+			return
+		}
+		arr := (*C.int)(C.malloc(C.size_t(len(ids)) * C.sizeof_int))
+		slice := (*[1 << 30]C.int)(unsafe.Pointer(arr))
+		for i, id := range ids {
+			slice[i] = C.int(id)
+		}
+		*dst = arr
+		*count = C.int(len(ids))
+		allocated = append(allocated, unsafe.Pointer(arr))
+	}
+	setIDs(genConfig.EosIds, &cfg.eos_ids, &cfg.eos_ids_count)
+	setIDs(genConfig.StopIds, &cfg.stop_ids, &cfg.stop_ids_count)
+	setIDs(genConfig.BadIds, &cfg.bad_ids, &cfg.bad_ids_count)
+
+	return cGenerationConfig{
+		value: cfg,
+		free: func() {
+			for _, p := range allocated {
+				C.free(p)
+			}
+		},
 	}
-	
-	result := &ForwardResult{handle: handle}
-	runtime.SetFinalizer(result, (*ForwardResult).Close)
-	return result, nil
 }
 
 // EndSession ends an inference session
 func (mi *ModelInstance) EndSession(sessionID uint64) {
-	if mi.handle != nil {
-		C.turbomind_end_session(mi.handle, C.uint64_t(sessionID))
+	if mi.life.enter(ErrInstanceClosed) != nil {
+		return
 	}
+	defer mi.life.leave()
+	C.turbomind_end_session(mi.handle, C.uint64_t(sessionID))
 }
 
 // Cancel cancels current request
 func (mi *ModelInstance) Cancel() {
-	if mi.handle != nil {
-		C.turbomind_cancel_request(mi.handle)
+	if mi.life.enter(ErrInstanceClosed) != nil {
+		return
 	}
+	defer mi.life.leave()
+	C.turbomind_cancel_request(mi.handle)
 }
 
 // NewTensor creates a new tensor
@@ -309,28 +600,60 @@ func NewTensor(data unsafe.Pointer, shape []int64, dtype DataType, memory Memory
 	handle := C.turbomind_create_tensor(data, cShape, C.int(len(shape)), 
 		C.TurboMindDataType(dtype), C.TurboMindMemoryType(memory), C.int(deviceID))
 	if handle == nil {
-		return nil, fmt.Errorf("failed to create tensor: %s", GetLastError())
+		return nil, fmt.Errorf("failed to create tensor: %s", getLastInstanceError())
 	}
 	
 	tensor := &Tensor{
-		handle: handle,
-		shape:  make([]int64, len(shape)),
-		dtype:  dtype,
-		memory: memory,
+		handle:    handle,
+		shape:     make([]int64, len(shape)),
+		dtype:     dtype,
+		memory:    memory,
+		life:      &lifecycle{},
+		sizeBytes: int64(C.turbomind_get_tensor_size(handle)),
 	}
 	copy(tensor.shape, shape)
-	
+
+	atomic.AddInt64(&TensorCount, 1)
+	atomic.AddInt64(&AllocatedBytes, tensor.sizeBytes)
+
 	runtime.SetFinalizer(tensor, (*Tensor).Close)
 	return tensor, nil
 }
 
+// Named sets the tensor's debug name and registers it in the live-tensor
+// registry DumpLiveTensors reports. Call it right after NewTensor; Close
+// removes the registry entry.
+func (t *Tensor) Named(name string) *Tensor {
+	if t.Name != "" {
+		liveTensors.Delete(t.Name)
+	}
+	t.Name = name
+	if name != "" {
+		liveTensors.Store(name, TensorInfo{Name: name, Shape: t.shape, DType: t.dtype, Memory: t.memory})
+	}
+	return t
+}
+
 // Close destroys the tensor
 func (t *Tensor) Close() {
-	if t.handle != nil {
-		C.turbomind_destroy_tensor(t.handle)
-		t.handle = nil
+	if t.borrowed {
+		// Owned by the TensorMap that returned it from Get; the map's own
+		// Close destroys the handle, so there's nothing to release here.
 		runtime.SetFinalizer(t, nil)
-	}
+		return
+	}
+	t.life.shutdown(func() {
+		if t.handle != nil {
+			C.turbomind_destroy_tensor(t.handle)
+			t.handle = nil
+			atomic.AddInt64(&TensorCount, -1)
+			atomic.AddInt64(&AllocatedBytes, -t.sizeBytes)
+			if t.Name != "" {
+				liveTensors.Delete(t.Name)
+			}
+		}
+	})
+	runtime.SetFinalizer(t, nil)
 }
 
 // Shape returns tensor shape
@@ -350,23 +673,55 @@ func (t *Tensor) MemoryType() MemoryType {
 
 // Size returns tensor size in bytes
 func (t *Tensor) Size() int {
-	if t.handle == nil {
+	if err := t.life.enter(errors.New("tensor is closed")); err != nil {
 		return 0
 	}
+	defer t.life.leave()
 	return int(C.turbomind_get_tensor_size(t.handle))
 }
 
+// CopyToHost copies the tensor's data to a host buffer via
+// turbomind_tensor_copy_to_host - for a GPU-resident tensor this performs
+// the device-to-host transfer; for a CPU tensor it's a plain copy - and
+// returns the raw bytes for the caller to reinterpret according to
+// DataType and Shape.
+func (t *Tensor) CopyToHost() ([]byte, error) {
+	if err := t.life.enter(errors.New("tensor is closed")); err != nil {
+		return nil, err
+	}
+	defer t.life.leave()
+
+	size := C.turbomind_get_tensor_size(t.handle)
+	if size == 0 {
+		return nil, nil
+	}
+
+	buf := C.malloc(size)
+	defer C.free(buf)
+
+	if C.turbomind_tensor_copy_to_host(t.handle, buf, size) != 0 {
+		return nil, fmt.Errorf("failed to copy tensor to host: %s", getLastInstanceError())
+	}
+
+	return C.GoBytes(buf, C.int(size)), nil
+}
+
 // CopyFrom copies data from another tensor
 func (t *Tensor) CopyFrom(src *Tensor) error {
-	if t.handle == nil || src.handle == nil {
-		return errors.New("tensor is closed")
+	if err := t.life.enter(errors.New("tensor is closed")); err != nil {
+		return err
 	}
-	
+	defer t.life.leave()
+	if err := src.life.enter(errors.New("tensor is closed")); err != nil {
+		return err
+	}
+	defer src.life.leave()
+
 	C.turbomind_copy_tensor(t.handle, src.handle)
-	if err := GetLastError(); err != "" {
+	if err := getLastInstanceError(); err != "" {
 		return fmt.Errorf("copy failed: %s", err)
 	}
-	
+
 	return nil
 }
 
@@ -376,33 +731,45 @@ func NewTensorMap() *TensorMap {
 	if handle == nil {
 		return nil
 	}
-	
-	tensorMap := &TensorMap{handle: handle}
+
+	tensorMap := &TensorMap{handle: handle, life: &lifecycle{}}
+	atomic.AddInt64(&TensorMapCount, 1)
 	runtime.SetFinalizer(tensorMap, (*TensorMap).Close)
 	return tensorMap
 }
 
 // Close destroys the tensor map
 func (tm *TensorMap) Close() {
-	if tm.handle != nil {
-		C.turbomind_destroy_tensor_map(tm.handle)
-		tm.handle = nil
+	if tm.borrowed {
+		// Owned by the ForwardResult that returned it from OutputTensors;
+		// the result's own Close destroys the handle, so there's nothing to
+		// release here.
 		runtime.SetFinalizer(tm, nil)
+		return
 	}
+	tm.life.shutdown(func() {
+		if tm.handle != nil {
+			C.turbomind_destroy_tensor_map(tm.handle)
+			tm.handle = nil
+			atomic.AddInt64(&TensorMapCount, -1)
+		}
+	})
+	runtime.SetFinalizer(tm, nil)
 }
 
 // Set sets a tensor in the map
 func (tm *TensorMap) Set(key string, tensor *Tensor) error {
-	if tm.handle == nil {
-		return errors.New("tensor map is closed")
+	if err := tm.life.enter(errors.New("tensor map is closed")); err != nil {
+		return err
 	}
-	
+	defer tm.life.leave()
+
 	cKey := C.CString(key)
 	defer C.free(unsafe.Pointer(cKey))
 	
 	result := C.turbomind_tensor_map_set(tm.handle, cKey, tensor.handle)
 	if result != 0 {
-		return fmt.Errorf("failed to set tensor: %s", GetLastError())
+		return fmt.Errorf("failed to set tensor: %s", getLastInstanceError())
 	}
 	
 	return nil
@@ -410,10 +777,11 @@ func (tm *TensorMap) Set(key string, tensor *Tensor) error {
 
 // Get gets a tensor from the map
 func (tm *TensorMap) Get(key string) (*Tensor, error) {
-	if tm.handle == nil {
-		return nil, errors.New("tensor map is closed")
+	if err := tm.life.enter(errors.New("tensor map is closed")); err != nil {
+		return nil, err
 	}
-	
+	defer tm.life.leave()
+
 	cKey := C.CString(key)
 	defer C.free(unsafe.Pointer(cKey))
 	
@@ -421,18 +789,52 @@ func (tm *TensorMap) Get(key string) (*Tensor, error) {
 	if handle == nil {
 		return nil, fmt.Errorf("tensor not found: %s", key)
 	}
-	
-	// Note: This is a simplified implementation - proper lifetime management needed
-	return &Tensor{handle: handle}, nil
+
+	// handle is owned by tm, not this Tensor: mark it borrowed so Close
+	// doesn't destroy it (and doesn't double-free when tm is destroyed),
+	// skip the finalizer since there's nothing for it to release, and
+	// share tm's lifecycle so a concurrent tm.Close waits for this
+	// Tensor's own in-flight accesses before freeing the handle.
+	return &Tensor{handle: handle, borrowed: true, life: tm.life}, nil
+}
+
+// OutputTensors returns the TensorMap holding this result's output
+// tensors (output_ids, sequence_length, and any requested logprobs/hidden
+// states), fetched from the C forward result via
+// turbomind_forward_result_get_tensor_map on first call and cached in
+// Tensors thereafter. The returned TensorMap is owned by fr: it stays
+// valid until fr.Close and must not be closed by the caller.
+func (fr *ForwardResult) OutputTensors() (*TensorMap, error) {
+	if err := fr.life.enter(errors.New("forward result is closed")); err != nil {
+		return nil, err
+	}
+	defer fr.life.leave()
+
+	if fr.Tensors != nil {
+		return fr.Tensors, nil
+	}
+
+	handle := C.turbomind_forward_result_get_tensor_map(fr.handle)
+	if handle == nil {
+		return nil, fmt.Errorf("failed to get output tensors: %s", getLastInstanceError())
+	}
+	// Share fr's lifecycle so a concurrent fr.Close waits for this
+	// TensorMap's own in-flight accesses (and any Tensor borrowed from it)
+	// before freeing the underlying forward result.
+	fr.Tensors = &TensorMap{handle: handle, borrowed: true, life: &fr.life}
+	return fr.Tensors, nil
 }
 
 // Close destroys the forward result
 func (fr *ForwardResult) Close() {
-	if fr.handle != nil {
-		C.turbomind_destroy_forward_result(fr.handle)
-		fr.handle = nil
-		runtime.SetFinalizer(fr, nil)
-	}
+	fr.life.shutdown(func() {
+		if fr.handle != nil {
+			C.turbomind_destroy_forward_result(fr.handle)
+			fr.handle = nil
+			atomic.AddInt64(&ForwardResultCount, -1)
+		}
+	})
+	runtime.SetFinalizer(fr, nil)
 }
 
 // Utility functions
@@ -442,8 +844,11 @@ func SetDevice(deviceID int) {
 	C.turbomind_set_device(C.int(deviceID))
 }
 
-// GetLastError returns the last error message
-func GetLastError() string {
+// getLastInstanceError returns the last error message from this file's
+// statically-linked cgo bridge - distinct from the package's public
+// GetLastError, which reads from the purego-loaded library turbomind.go
+// drives.
+func getLastInstanceError() string {
 	return C.GoString(C.turbomind_get_last_error())
 }
 